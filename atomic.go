@@ -0,0 +1,110 @@
+package goptional
+
+import "sync/atomic"
+
+// AtomicOptional is an Optional that can be safely shared across goroutines.
+// Its zero value is empty and ready to use; it must not be copied after first use.
+//
+// It never hands out the instance it holds: Optionals returned by its methods are
+// snapshots that callers are free to mutate, and Optionals given to it are copied.
+type AtomicOptional[T any] struct {
+	p atomic.Pointer[Optional[T]]
+}
+
+// NewAtomic returns a new AtomicOptional holding a copy of o.
+func NewAtomic[T any](o *Optional[T]) *AtomicOptional[T] {
+	a := &AtomicOptional[T]{}
+	a.p.Store(snapshot(o))
+	return a
+}
+
+// Load returns a snapshot of the current state of this instance.
+func (a *AtomicOptional[T]) Load() *Optional[T] {
+	if a == nil {
+		return Empty[T]()
+	}
+
+	return snapshot(a.p.Load())
+}
+
+// Store atomically sets the state of this instance to a copy of o.
+// It returns an ErrMutationOnNil error if this instance is nil.
+func (a *AtomicOptional[T]) Store(o *Optional[T]) error {
+	if a == nil {
+		return ErrMutationOnNil
+	}
+
+	a.p.Store(snapshot(o))
+	return nil
+}
+
+// Swap atomically sets the state of this instance to a copy of o, returning its previous state.
+// It returns an ErrMutationOnNil error if this instance is nil.
+func (a *AtomicOptional[T]) Swap(o *Optional[T]) (*Optional[T], error) {
+	if a == nil {
+		return nil, ErrMutationOnNil
+	}
+
+	return snapshot(a.p.Swap(snapshot(o))), nil
+}
+
+// Take atomically takes the value out of this instance, if any, leaving it empty.
+func (a *AtomicOptional[T]) Take() *Optional[T] {
+	if a == nil {
+		return Empty[T]()
+	}
+
+	return snapshot(a.p.Swap(nil))
+}
+
+// Replace atomically replaces the value in this instance with the given value, returning the old value if present.
+// As with Optional.Replace, this instance is left empty if the given value is either invalid or nil.
+// It returns an ErrMutationOnNil error if this instance is nil.
+func (a *AtomicOptional[T]) Replace(value T) (*Optional[T], error) {
+	return a.Swap(Of(value))
+}
+
+// CompareAndSwap atomically sets the state of this instance to a copy of new
+// iff its current state equals old, as Optional.Equals would.
+// It returns true if the swap took place.
+func (a *AtomicOptional[T]) CompareAndSwap(old, new *Optional[T]) bool {
+	return a.CompareAndSwapBy(old, new, nil)
+}
+
+// CompareAndSwapBy atomically sets the state of this instance to a copy of new
+// iff its current state equals old, as Optional.EqualsBy would with the given predicate.
+// It returns true if the swap took place.
+func (a *AtomicOptional[T]) CompareAndSwapBy(old, new *Optional[T], predicate func(v1, v2 T) bool) bool {
+	if a == nil {
+		return false
+	}
+
+	next := snapshot(new)
+	for {
+		cur := a.p.Load()
+		if !cur.EqualsBy(old, predicate) {
+			return false
+		}
+
+		if a.p.CompareAndSwap(cur, next) {
+			return true
+		}
+	}
+}
+
+// CompareAndSwapComparable behaves as AtomicOptional.CompareAndSwap,
+// comparing values of comparable types with == rather than through reflection.
+func CompareAndSwapComparable[T comparable](a *AtomicOptional[T], old, new *Optional[T]) bool {
+	return a.CompareAndSwapBy(old, new, func(v1, v2 T) bool {
+		return v1 == v2
+	})
+}
+
+// snapshot returns a new Optional with the same state as o.
+func snapshot[T any](o *Optional[T]) *Optional[T] {
+	if o.IsEmpty() {
+		return Empty[T]()
+	}
+
+	return &Optional[T]{value: o.Unwrap(), isValueValid: true}
+}
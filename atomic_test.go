@@ -0,0 +1,159 @@
+package goptional
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtomicOptional_ZeroValue(t *testing.T) {
+	var a AtomicOptional[int]
+	require.True(t, a.Load().IsEmpty())
+	require.True(t, a.Take().IsEmpty())
+
+	require.NoError(t, a.Store(Of(1)))
+	require.EqualValues(t, a.Load().Unwrap(), 1)
+}
+
+func TestAtomicOptional_Nil(t *testing.T) {
+	var a *AtomicOptional[int]
+	require.True(t, a.Load().IsEmpty())
+	require.True(t, a.Take().IsEmpty())
+	require.ErrorIs(t, a.Store(Of(1)), ErrMutationOnNil)
+
+	_, err := a.Swap(Of(1))
+	require.ErrorIs(t, err, ErrMutationOnNil)
+
+	_, err = a.Replace(1)
+	require.ErrorIs(t, err, ErrMutationOnNil)
+
+	require.False(t, a.CompareAndSwap(nil, Of(1)))
+}
+
+func TestAtomicOptional_Snapshots(t *testing.T) {
+	opt := Of(1)
+	a := NewAtomic(opt)
+
+	_, err := opt.Replace(2)
+	require.NoError(t, err)
+	require.EqualValues(t, a.Load().Unwrap(), 1)
+
+	loaded := a.Load()
+	loaded.Take()
+	require.EqualValues(t, a.Load().Unwrap(), 1)
+}
+
+func TestAtomicOptional_SwapTakeReplace(t *testing.T) {
+	a := NewAtomic(Empty[string]())
+
+	old, err := a.Swap(Of("a"))
+	require.NoError(t, err)
+	require.True(t, old.IsEmpty())
+
+	old, err = a.Replace("b")
+	require.NoError(t, err)
+	require.EqualValues(t, old.Unwrap(), "a")
+
+	require.EqualValues(t, a.Take().Unwrap(), "b")
+	require.True(t, a.Load().IsEmpty())
+
+	old, err = a.Replace("c")
+	require.NoError(t, err)
+	require.True(t, old.IsEmpty())
+
+	old, err = a.Swap(nil)
+	require.NoError(t, err)
+	require.EqualValues(t, old.Unwrap(), "c")
+	require.True(t, a.Load().IsEmpty())
+}
+
+func TestAtomicOptional_CompareAndSwap(t *testing.T) {
+	a := NewAtomic(Of([]int{1}))
+
+	require.False(t, a.CompareAndSwap(Of([]int{2}), Of([]int{3})))
+	require.EqualValues(t, a.Load().Unwrap(), []int{1})
+
+	require.True(t, a.CompareAndSwap(Of([]int{1}), Empty[[]int]()))
+	require.True(t, a.Load().IsEmpty())
+
+	require.True(t, a.CompareAndSwap(nil, Of([]int{4})))
+	require.EqualValues(t, a.Load().Unwrap(), []int{4})
+
+	byLen := func(v1, v2 []int) bool { return len(v1) == len(v2) }
+	require.True(t, a.CompareAndSwapBy(Of([]int{0}), Of([]int{5, 6}), byLen))
+	require.EqualValues(t, a.Load().Unwrap(), []int{5, 6})
+}
+
+func TestCompareAndSwapComparable(t *testing.T) {
+	a := NewAtomic(Of(1))
+	require.False(t, CompareAndSwapComparable(a, Of(2), Of(3)))
+	require.True(t, CompareAndSwapComparable(a, Of(1), Of(3)))
+	require.EqualValues(t, a.Load().Unwrap(), 3)
+}
+
+func TestAtomicOptional_Concurrent(t *testing.T) {
+	const goroutines, iterations = 16, 1000
+
+	var (
+		a  AtomicOptional[int]
+		wg sync.WaitGroup
+	)
+	require.NoError(t, a.Store(Of(0)))
+
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				for {
+					cur := a.Load()
+					if CompareAndSwapComparable(&a, cur, Of(cur.Unwrap()+1)) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.EqualValues(t, a.Load().Unwrap(), goroutines*iterations)
+}
+
+func TestAtomicOptional_ConcurrentTake(t *testing.T) {
+	const goroutines, iterations = 16, 1000
+
+	var (
+		a     AtomicOptional[int]
+		taken int64
+		wg    sync.WaitGroup
+	)
+
+	wg.Add(2 * goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				for !a.CompareAndSwap(nil, Of(1)) {
+					runtime.Gosched()
+				}
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt64(&taken) < goroutines*iterations {
+				a.Take().IfPresent(func(v int) {
+					atomic.AddInt64(&taken, int64(v))
+				})
+				runtime.Gosched()
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.EqualValues(t, taken, goroutines*iterations)
+	require.True(t, a.Load().IsEmpty())
+}
@@ -0,0 +1,130 @@
+package goptional
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"reflect"
+)
+
+// ErrInvalidBinary indicates that the given data is not a valid binary representation of an Optional.
+var ErrInvalidBinary = errors.New("invalid binary data")
+
+// Presence bytes leading the binary representation of an Optional.
+const (
+	binaryEmpty   byte = 0
+	binaryPresent byte = 1
+)
+
+var binaryMarshalerType = reflect.TypeOf((*encoding.BinaryMarshaler)(nil)).Elem()
+var binaryUnmarshalerType = reflect.TypeOf((*encoding.BinaryUnmarshaler)(nil)).Elem()
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The result is a presence byte followed, if this instance is not empty,
+// by the value encoded through its own encoding.BinaryMarshaler, if any, or through gob otherwise.
+func (o *Optional[T]) MarshalBinary() ([]byte, error) {
+	if o.IsEmpty() {
+		return []byte{binaryEmpty}, nil
+	}
+
+	v := o.Unwrap()
+	if hasBinaryCodec(reflect.TypeOf(&v).Elem()) {
+		data, err := binaryMarshaler(reflect.ValueOf(&v).Elem()).MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{binaryPresent}, data...), nil
+	}
+
+	buf := bytes.NewBuffer([]byte{binaryPresent})
+	if err := gob.NewEncoder(buf).Encode(&v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It populates this instance with data produced by MarshalBinary.
+//
+// It returns an ErrMutationOnNil error if this instance is nil,
+// or an ErrInvalidBinary error if data does not start with a valid presence byte.
+func (o *Optional[T]) UnmarshalBinary(data []byte) error {
+	if o == nil {
+		return ErrMutationOnNil
+	}
+
+	if len(data) == 0 {
+		return ErrInvalidBinary
+	}
+
+	switch data[0] {
+	case binaryEmpty:
+		if len(data) != 1 {
+			return ErrInvalidBinary
+		}
+		o.unsetValue()
+		return nil
+	case binaryPresent:
+	default:
+		return ErrInvalidBinary
+	}
+
+	var value T
+	rv := reflect.ValueOf(&value).Elem()
+	if hasBinaryCodec(rv.Type()) {
+		if err := binaryUnmarshaler(rv).UnmarshalBinary(data[1:]); err != nil {
+			return err
+		}
+	} else if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(&value); err != nil {
+		return err
+	}
+	o.setValue(value)
+
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+// It relies on MarshalBinary.
+func (o *Optional[T]) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+// It relies on UnmarshalBinary.
+func (o *Optional[T]) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// hasBinaryCodec returns true if values of type t can be both
+// encoded through encoding.BinaryMarshaler and decoded through encoding.BinaryUnmarshaler.
+func hasBinaryCodec(t reflect.Type) bool {
+	canMarshal := t.Implements(binaryMarshalerType) || reflect.PtrTo(t).Implements(binaryMarshalerType)
+	canUnmarshal := (t.Kind() == reflect.Ptr && t.Implements(binaryUnmarshalerType)) ||
+		reflect.PtrTo(t).Implements(binaryUnmarshalerType)
+
+	return canMarshal && canUnmarshal
+}
+
+// binaryMarshaler returns the encoding.BinaryMarshaler of the given addressable value.
+func binaryMarshaler(v reflect.Value) encoding.BinaryMarshaler {
+	if v.Type().Implements(binaryMarshalerType) {
+		return v.Interface().(encoding.BinaryMarshaler)
+	}
+
+	return v.Addr().Interface().(encoding.BinaryMarshaler)
+}
+
+// binaryUnmarshaler returns the encoding.BinaryUnmarshaler of the given addressable value,
+// allocating the pointee if the value is a nil pointer.
+func binaryUnmarshaler(v reflect.Value) encoding.BinaryUnmarshaler {
+	if v.Kind() == reflect.Ptr && v.Type().Implements(binaryUnmarshalerType) {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return v.Interface().(encoding.BinaryUnmarshaler)
+	}
+
+	return v.Addr().Interface().(encoding.BinaryUnmarshaler)
+}
@@ -0,0 +1,153 @@
+package goptional
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type sampleBinary struct {
+	n byte
+}
+
+func (b sampleBinary) MarshalBinary() ([]byte, error) {
+	return []byte{b.n}, nil
+}
+
+func (b *sampleBinary) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 {
+		return errors.New("woops")
+	}
+	b.n = data[0]
+	return nil
+}
+
+type sampleCached struct {
+	Name  Optional[string]
+	Age   Optional[int]
+	Tags  Optional[[]string]
+	Inner *Optional[sampleStruct]
+}
+
+func TestMarshalBinary_Empty(t *testing.T) {
+	data, err := Empty[int]().MarshalBinary()
+	require.NoError(t, err)
+	require.EqualValues(t, data, []byte{binaryEmpty})
+
+	var opt *Optional[int]
+	data, err = opt.MarshalBinary()
+	require.NoError(t, err)
+	require.EqualValues(t, data, []byte{binaryEmpty})
+}
+
+func TestMarshalBinary_Delegates(t *testing.T) {
+	data, err := Of(sampleBinary{n: 7}).MarshalBinary()
+	require.NoError(t, err)
+	require.EqualValues(t, data, []byte{binaryPresent, 7})
+
+	data, err = Of(&sampleBinary{n: 8}).MarshalBinary()
+	require.NoError(t, err)
+	require.EqualValues(t, data, []byte{binaryPresent, 8})
+}
+
+func TestMarshalBinary_Unsupported(t *testing.T) {
+	_, err := Of(func() {}).MarshalBinary()
+	require.Error(t, err)
+}
+
+func TestUnmarshalBinary_Nil(t *testing.T) {
+	var opt *Optional[int]
+	require.ErrorIs(t, opt.UnmarshalBinary([]byte{binaryEmpty}), ErrMutationOnNil)
+}
+
+func TestUnmarshalBinary_InvalidData(t *testing.T) {
+	opt := Of(123)
+	require.ErrorIs(t, opt.UnmarshalBinary(nil), ErrInvalidBinary)
+	require.ErrorIs(t, opt.UnmarshalBinary([]byte{2}), ErrInvalidBinary)
+	require.ErrorIs(t, opt.UnmarshalBinary([]byte{binaryEmpty, 1}), ErrInvalidBinary)
+	require.Error(t, opt.UnmarshalBinary([]byte{binaryPresent, 1, 2, 3}))
+	require.EqualValues(t, opt.Unwrap(), 123)
+
+	optBin := Empty[sampleBinary]()
+	require.Error(t, optBin.UnmarshalBinary([]byte{binaryPresent}))
+	require.True(t, optBin.IsEmpty())
+}
+
+func TestUnmarshalBinary_EmptyOnNotEmpty(t *testing.T) {
+	opt := Of(123)
+	require.NoError(t, opt.UnmarshalBinary([]byte{binaryEmpty}))
+	require.True(t, opt.IsEmpty())
+}
+
+func TestBinary_RoundTrip(t *testing.T) {
+	now := time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	roundTrip := func(in, out interface {
+		MarshalBinary() ([]byte, error)
+		UnmarshalBinary([]byte) error
+	}) {
+		data, err := in.MarshalBinary()
+		require.NoError(t, err)
+		require.NoError(t, out.UnmarshalBinary(data))
+	}
+
+	optInt := Empty[int]()
+	roundTrip(Of(123), optInt)
+	require.EqualValues(t, optInt.Unwrap(), 123)
+
+	optStr := Empty[string]()
+	roundTrip(Of(""), optStr)
+	require.True(t, optStr.IsPresent())
+
+	optTime := Empty[time.Time]()
+	roundTrip(Of(now), optTime)
+	require.True(t, optTime.Unwrap().Equal(now))
+
+	optStruct := Empty[*sampleStruct]()
+	roundTrip(Of(sampleStructInst), optStruct)
+	require.EqualValues(t, optStruct.Unwrap(), sampleStructInst)
+
+	optBin := Empty[*sampleBinary]()
+	roundTrip(Of(&sampleBinary{n: 9}), optBin)
+	require.EqualValues(t, optBin.Unwrap(), &sampleBinary{n: 9})
+
+	optNested := Empty[*Optional[int]]()
+	roundTrip(Of(Of(123)), optNested)
+	require.EqualValues(t, optNested.Unwrap().Unwrap(), 123)
+
+	optEmpty := Of(123)
+	roundTrip(Empty[int](), optEmpty)
+	require.True(t, optEmpty.IsEmpty())
+}
+
+func TestGob_RoundTrip(t *testing.T) {
+	in := sampleCached{
+		Name:  *Of("gm"),
+		Tags:  *Of([]string{"a", "b"}),
+		Inner: Of(*sampleStructInst),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(&in))
+
+	out := sampleCached{Age: *Of(123)}
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+
+	require.EqualValues(t, out.Name.Unwrap(), "gm")
+	require.True(t, out.Age.IsEmpty())
+	require.EqualValues(t, out.Tags.Unwrap(), []string{"a", "b"})
+	require.EqualValues(t, out.Inner.Unwrap(), *sampleStructInst)
+}
+
+func TestGob_TopLevel(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(Of(123)))
+
+	opt := Empty[int]()
+	require.NoError(t, gob.NewDecoder(&buf).Decode(opt))
+	require.EqualValues(t, opt.Unwrap(), 123)
+}
@@ -0,0 +1,68 @@
+package goptional
+
+import "context"
+
+// Recv blocks until a value is received from ch, and returns an Optional holding it, as Of would.
+// It returns an empty Optional if ch is closed.
+// As with any receive, it blocks forever if ch is nil.
+func Recv[T any](ch <-chan T) *Optional[T] {
+	v, ok := <-ch
+	return FromOk(v, ok)
+}
+
+// TryRecv returns an Optional holding a value received from ch, as Of would, without blocking.
+// It returns an empty Optional if no value is ready, or if ch is either closed or nil.
+func TryRecv[T any](ch <-chan T) *Optional[T] {
+	select {
+	case v, ok := <-ch:
+		return FromOk(v, ok)
+	default:
+		return Empty[T]()
+	}
+}
+
+// RecvCtx blocks until a value is received from ch or ctx is done, and returns an Optional holding
+// the received value, as Of would.
+// It returns an empty Optional if ch is closed, or if ctx is done first.
+func RecvCtx[T any](ctx context.Context, ch <-chan T) *Optional[T] {
+	select {
+	case v, ok := <-ch:
+		return FromOk(v, ok)
+	case <-ctx.Done():
+		return Empty[T]()
+	}
+}
+
+// CompactChan returns a channel that emits the values of the non-empty Optionals received from in,
+// preserving their order and skipping empty ones.
+// The returned channel is closed once in is closed and all its values have been emitted, or once ctx is done,
+// so that the underlying goroutine never outlives either.
+func CompactChan[T any](ctx context.Context, in <-chan *Optional[T]) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case o, ok := <-in:
+				if !ok {
+					return
+				}
+				if o.IsEmpty() {
+					continue
+				}
+
+				select {
+				case out <- o.Unwrap():
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
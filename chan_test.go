@@ -0,0 +1,101 @@
+package goptional
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecv(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 123
+	require.EqualValues(t, Recv(ch).Unwrap(), 123)
+
+	close(ch)
+	require.True(t, Recv(ch).IsEmpty())
+
+	ptrs := make(chan *int, 1)
+	ptrs <- nil
+	require.True(t, Recv(ptrs).IsEmpty())
+}
+
+func TestTryRecv(t *testing.T) {
+	ch := make(chan int, 1)
+	require.True(t, TryRecv(ch).IsEmpty())
+
+	ch <- 123
+	require.EqualValues(t, TryRecv(ch).Unwrap(), 123)
+
+	close(ch)
+	require.True(t, TryRecv(ch).IsEmpty())
+
+	var nilCh chan int
+	require.True(t, TryRecv(nilCh).IsEmpty())
+}
+
+func TestRecvCtx(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 123
+	require.EqualValues(t, RecvCtx(context.Background(), ch).Unwrap(), 123)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.True(t, RecvCtx(ctx, ch).IsEmpty())
+
+	close(ch)
+	require.True(t, RecvCtx(context.Background(), ch).IsEmpty())
+}
+
+func TestCompactChan(t *testing.T) {
+	in := make(chan *Optional[int])
+	out := CompactChan(context.Background(), in)
+
+	go func() {
+		defer close(in)
+		for _, o := range []*Optional[int]{Of(1), Empty[int](), nil, Of(2), Of(3)} {
+			in <- o
+		}
+	}()
+
+	var got []int
+	for v := range out {
+		got = append(got, v)
+	}
+	require.Equal(t, []int{1, 2, 3}, got)
+}
+
+func TestCompactChan_ContextDone(t *testing.T) {
+	in := make(chan *Optional[int])
+	ctx, cancel := context.WithCancel(context.Background())
+	out := CompactChan(ctx, in)
+
+	in <- Of(1)
+	// The value is pending on out: cancelling must not block the stage.
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			// The pending value may win the race against cancellation.
+			_, ok = <-out
+		}
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("out should be closed once ctx is done")
+	}
+}
+
+func TestCompactChan_ContextDoneWhileIdle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	out := CompactChan(ctx, make(chan *Optional[int]))
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("out should be closed once ctx is done")
+	}
+}
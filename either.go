@@ -0,0 +1,230 @@
+package goptional
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// Either represents a value of one of two possible types.
+// At any time it either holds a Left value of type L or a Right value of type R.
+//
+// The zero value of Either is Left and holds the zero value of L,
+// while a nil *Either[L, R] holds neither.
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// ErrUnknownEitherSide indicates that the JSON representation of an Either has an unknown discriminator.
+var ErrUnknownEitherSide = errors.New("unknown either side")
+
+// JSON discriminators of the two sides of an Either.
+const (
+	eitherLeft  = "left"
+	eitherRight = "right"
+)
+
+// eitherJSON is the JSON representation of an Either.
+type eitherJSON struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Left returns a new Either holding the given Left value.
+func Left[L, R any](value L) *Either[L, R] {
+	return &Either[L, R]{left: value}
+}
+
+// Right returns a new Either holding the given Right value.
+func Right[L, R any](value R) *Either[L, R] {
+	return &Either[L, R]{right: value, isRight: true}
+}
+
+// IsLeft returns true if this instance holds a Left value, and false otherwise.
+func (e *Either[L, R]) IsLeft() bool {
+	return e != nil && !e.isRight
+}
+
+// IsRight returns true if this instance holds a Right value, and false otherwise.
+func (e *Either[L, R]) IsRight() bool {
+	return e != nil && e.isRight
+}
+
+// LeftOpt returns an Optional holding the Left value of this instance, if any, or an empty Optional otherwise.
+func (e *Either[L, R]) LeftOpt() *Optional[L] {
+	if !e.IsLeft() {
+		return Empty[L]()
+	}
+
+	return Of(e.left)
+}
+
+// RightOpt returns an Optional holding the Right value of this instance, if any, or an empty Optional otherwise.
+func (e *Either[L, R]) RightOpt() *Optional[R] {
+	if !e.IsRight() {
+		return Empty[R]()
+	}
+
+	return Of(e.right)
+}
+
+// Swap returns a new Either whose Left value is the Right value of this instance and vice versa.
+// If this instance is nil, it returns nil.
+func (e *Either[L, R]) Swap() *Either[R, L] {
+	if e == nil {
+		return nil
+	}
+
+	if e.isRight {
+		return Left[R, L](e.right)
+	}
+
+	return Right[R, L](e.left)
+}
+
+// MarshalJSON returns the JSON representation of this instance,
+// i.e. an object holding the side ("left" or "right") under "type" and the value under "value".
+func (e *Either[L, R]) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		return nilAsJSON, nil
+	}
+
+	var (
+		side  string
+		value []byte
+		err   error
+	)
+	if e.isRight {
+		side = eitherRight
+		value, err = json.Marshal(e.right)
+	} else {
+		side = eitherLeft
+		value, err = json.Marshal(e.left)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(eitherJSON{Type: side, Value: value})
+}
+
+// UnmarshalJSON populates this instance with the given JSON data, as produced by MarshalJSON.
+// A null leaves this instance unchanged.
+//
+// It returns an ErrMutationOnNil error if this instance is nil,
+// or an ErrUnknownEitherSide error if the discriminator is neither "left" nor "right".
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	if e == nil {
+		return ErrMutationOnNil
+	}
+
+	var ej eitherJSON
+	if err := json.Unmarshal(data, &ej); err != nil {
+		return err
+	}
+
+	if ej.Type == "" && ej.Value == nil {
+		return nil
+	}
+
+	switch ej.Type {
+	case eitherLeft:
+		var left L
+		if err := json.Unmarshal(ej.Value, &left); err != nil {
+			return err
+		}
+		*e = Either[L, R]{left: left}
+	case eitherRight:
+		var right R
+		if err := json.Unmarshal(ej.Value, &right); err != nil {
+			return err
+		}
+		*e = Either[L, R]{right: right, isRight: true}
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownEitherSide, ej.Type)
+	}
+
+	return nil
+}
+
+// String returns the string representation of this instance.
+func (e *Either[L, R]) String() string {
+	if e == nil {
+		return "Either.none"
+	}
+
+	if e.isRight {
+		return spew.Sprintf("Either.right[%#+v]", e.right)
+	}
+
+	return spew.Sprintf("Either.left[%#+v]", e.left)
+}
+
+// MapLeft returns one of the following:
+//   - a new Either holding the Right value of input if it holds a Right value
+//   - a new Either holding a Left value that results from the application of the given mapper to the Left value of input
+//
+// If input is nil, or if it holds a Left value and mapper is nil, it returns nil.
+func MapLeft[L, R, X any](input *Either[L, R], mapper func(L) X) *Either[X, R] {
+	if input == nil {
+		return nil
+	}
+
+	if input.isRight {
+		return Right[X](input.right)
+	}
+
+	if mapper == nil {
+		return nil
+	}
+
+	return Left[X, R](mapper(input.left))
+}
+
+// MapRight returns one of the following:
+//   - a new Either holding the Left value of input if it holds a Left value
+//   - a new Either holding a Right value that results from the application of the given mapper to the Right value of input
+//
+// If input is nil, or if it holds a Right value and mapper is nil, it returns nil.
+func MapRight[L, R, X any](input *Either[L, R], mapper func(R) X) *Either[L, X] {
+	if input == nil {
+		return nil
+	}
+
+	if !input.isRight {
+		return Left[L, X](input.left)
+	}
+
+	if mapper == nil {
+		return nil
+	}
+
+	return Right[L](mapper(input.right))
+}
+
+// FoldEither returns the result of the application of onLeft to the Left value of input,
+// or of onRight to its Right value, whichever is held.
+//
+// It returns the zero value of X if input is nil or if the function matching the held side is nil.
+func FoldEither[L, R, X any](input *Either[L, R], onLeft func(L) X, onRight func(R) X) X {
+	if input == nil {
+		return getZeroOfType[X]()
+	}
+
+	if input.isRight {
+		if onRight == nil {
+			return getZeroOfType[X]()
+		}
+		return onRight(input.right)
+	}
+
+	if onLeft == nil {
+		return getZeroOfType[X]()
+	}
+
+	return onLeft(input.left)
+}
@@ -0,0 +1,148 @@
+package goptional
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type sampleCache struct {
+	Entry *Either[string, int] `json:"entry"`
+}
+
+func TestLeft(t *testing.T) {
+	e := Left[string, int]("gm")
+	require.True(t, e.IsLeft())
+	require.False(t, e.IsRight())
+	require.EqualValues(t, e.LeftOpt().Unwrap(), "gm")
+	require.True(t, e.RightOpt().IsEmpty())
+}
+
+func TestRight(t *testing.T) {
+	e := Right[string](123)
+	require.False(t, e.IsLeft())
+	require.True(t, e.IsRight())
+	require.True(t, e.LeftOpt().IsEmpty())
+	require.EqualValues(t, e.RightOpt().Unwrap(), 123)
+}
+
+func TestEither_Nil(t *testing.T) {
+	var e *Either[string, int]
+	require.False(t, e.IsLeft())
+	require.False(t, e.IsRight())
+	require.True(t, e.LeftOpt().IsEmpty())
+	require.True(t, e.RightOpt().IsEmpty())
+	require.Nil(t, e.Swap())
+	require.EqualValues(t, e.String(), "Either.none")
+}
+
+func TestEither_ZeroInst(t *testing.T) {
+	var e Either[string, int]
+	require.True(t, e.IsLeft())
+	require.EqualValues(t, e.LeftOpt().Unwrap(), "")
+}
+
+func TestEither_NilValue(t *testing.T) {
+	e := Left[[]int, int](nil)
+	require.True(t, e.IsLeft())
+	require.True(t, e.LeftOpt().IsEmpty())
+}
+
+func TestEither_Swap(t *testing.T) {
+	e := Left[string, int]("gm").Swap()
+	require.True(t, e.IsRight())
+	require.EqualValues(t, e.RightOpt().Unwrap(), "gm")
+
+	e2 := Right[string](123).Swap()
+	require.True(t, e2.IsLeft())
+	require.EqualValues(t, e2.LeftOpt().Unwrap(), 123)
+}
+
+func TestMapLeft(t *testing.T) {
+	e := MapLeft(Left[int, string](123), strconv.Itoa)
+	require.EqualValues(t, e.LeftOpt().Unwrap(), "123")
+
+	e = MapLeft(Right[int]("gm"), strconv.Itoa)
+	require.EqualValues(t, e.RightOpt().Unwrap(), "gm")
+
+	require.Nil(t, MapLeft[int, string, string](Left[int, string](123), nil))
+	require.True(t, MapLeft[int, string, string](Right[int]("gm"), nil).IsRight())
+	require.Nil(t, MapLeft[int, string](nil, strconv.Itoa))
+}
+
+func TestMapRight(t *testing.T) {
+	e := MapRight(Right[string](123), strconv.Itoa)
+	require.EqualValues(t, e.RightOpt().Unwrap(), "123")
+
+	e = MapRight(Left[string, int]("gm"), strconv.Itoa)
+	require.EqualValues(t, e.LeftOpt().Unwrap(), "gm")
+
+	require.Nil(t, MapRight[string, int, string](Right[string](123), nil))
+	require.True(t, MapRight[string, int, string](Left[string, int]("gm"), nil).IsLeft())
+	require.Nil(t, MapRight[string, int](nil, strconv.Itoa))
+}
+
+func TestFoldEither(t *testing.T) {
+	onLeft := func(s string) string { return "cached:" + s }
+	onRight := func(i int) string { return "fresh:" + strconv.Itoa(i) }
+
+	require.EqualValues(t, FoldEither(Left[string, int]("gm"), onLeft, onRight), "cached:gm")
+	require.EqualValues(t, FoldEither(Right[string](123), onLeft, onRight), "fresh:123")
+	require.EqualValues(t, FoldEither(Right[string](123), onLeft, nil), "")
+	require.EqualValues(t, FoldEither(Left[string, int]("gm"), nil, onRight), "")
+	require.EqualValues(t, FoldEither[string, int](nil, onLeft, onRight), "")
+}
+
+func TestEither_MarshalJSON(t *testing.T) {
+	jsonBytes, err := json.Marshal(&sampleCache{Entry: Left[string, int]("gm")})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"entry":{"type":"left","value":"gm"}}`, string(jsonBytes))
+
+	jsonBytes, err = json.Marshal(&sampleCache{Entry: Right[string](123)})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"entry":{"type":"right","value":123}}`, string(jsonBytes))
+
+	jsonBytes, err = json.Marshal(&sampleCache{})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"entry":null}`, string(jsonBytes))
+
+	var e *Either[string, int]
+	jsonBytes, err = e.MarshalJSON()
+	require.NoError(t, err)
+	require.EqualValues(t, jsonBytes, nilAsJSON)
+
+	_, err = Left[func(), int](func() {}).MarshalJSON()
+	require.Error(t, err)
+}
+
+func TestEither_UnmarshalJSON(t *testing.T) {
+	var c sampleCache
+	require.NoError(t, json.Unmarshal([]byte(`{"entry":{"type":"right","value":123}}`), &c))
+	require.EqualValues(t, c.Entry.RightOpt().Unwrap(), 123)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"entry":{"type":"left","value":"gm"}}`), &c))
+	require.EqualValues(t, c.Entry.LeftOpt().Unwrap(), "gm")
+
+	e := Right[string](123)
+	require.NoError(t, e.UnmarshalJSON(nilAsJSON))
+	require.EqualValues(t, e.RightOpt().Unwrap(), 123)
+}
+
+func TestEither_UnmarshalJSON_InvalidData(t *testing.T) {
+	var nilEither *Either[string, int]
+	require.ErrorIs(t, nilEither.UnmarshalJSON([]byte(`{}`)), ErrMutationOnNil)
+
+	e := Right[string](123)
+	require.ErrorIs(t, e.UnmarshalJSON([]byte(`{"type":"middle","value":1}`)), ErrUnknownEitherSide)
+	require.Error(t, e.UnmarshalJSON([]byte(`{"type":"left","value":1}`)))
+	require.Error(t, e.UnmarshalJSON([]byte(`{"type":"right","value":"gm"}`)))
+	require.Error(t, e.UnmarshalJSON([]byte(`[]`)))
+	require.EqualValues(t, e.RightOpt().Unwrap(), 123)
+}
+
+func TestEither_String(t *testing.T) {
+	require.EqualValues(t, Left[string, int]("gm").String(), `Either.left[(string)gm]`)
+	require.EqualValues(t, Right[string](123).String(), `Either.right[(int)123]`)
+}
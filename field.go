@@ -0,0 +1,119 @@
+package goptional
+
+import "github.com/davecgh/go-spew/spew"
+
+// Field represents an optional JSON field that distinguishes between three states:
+//   - unset: the key is absent
+//   - null: the key is present and explicitly set to null
+//   - present: the key is present and holds a value
+//
+// It is meant to be used as a value field (not a pointer) of structs decoded from
+// PATCH-like payloads, where an absent key and an explicit null carry different meanings.
+//
+// On go 1.24+, tagging a Field with `json:",omitzero"` omits it from the encoded output when unset.
+type Field[T any] struct {
+	value Optional[T]
+	isSet bool
+}
+
+// Unset returns a new unset Field.
+func Unset[T any]() *Field[T] {
+	return &Field[T]{}
+}
+
+// Null returns a new Field that is set to null.
+func Null[T any]() *Field[T] {
+	return &Field[T]{isSet: true}
+}
+
+// FieldOf returns a new set Field holding the given value.
+// If such value is either invalid or nil, the Field is set to null instead.
+func FieldOf[T any](value T) *Field[T] {
+	f := &Field[T]{isSet: true}
+	f.value.setValue(value)
+	return f
+}
+
+// FieldFrom returns a new set Field holding the value of o, if any, or set to null otherwise.
+func FieldFrom[T any](o *Optional[T]) *Field[T] {
+	if o.IsEmpty() {
+		return Null[T]()
+	}
+
+	return FieldOf(o.Unwrap())
+}
+
+// IsSet returns true if this instance was explicitly set, either to null or to a value.
+func (f *Field[T]) IsSet() bool {
+	return f != nil && f.isSet
+}
+
+// IsNull returns true if this instance was explicitly set to null.
+func (f *Field[T]) IsNull() bool {
+	return f.IsSet() && f.value.IsEmpty()
+}
+
+// IsPresent returns true if this instance holds a value.
+func (f *Field[T]) IsPresent() bool {
+	return f.IsSet() && f.value.IsPresent()
+}
+
+// IsZero returns true if this instance is unset.
+// It allows encoding/json to omit unset fields tagged with omitzero.
+//
+// Unlike other methods, IsZero has a value receiver so that it is also found
+// on Fields held by non-addressable values, e.g. structs marshalled by value or map values.
+func (f Field[T]) IsZero() bool {
+	return !f.isSet
+}
+
+// Optional returns a new Optional holding the value of this instance, if any, or an empty Optional otherwise.
+// Both unset and null instances result in an empty Optional.
+func (f *Field[T]) Optional() *Optional[T] {
+	if f.IsPresent() {
+		return Of(f.value.Unwrap())
+	}
+
+	return Empty[T]()
+}
+
+// MarshalJSON returns the JSON representation of this instance.
+// Both unset and null instances are encoded as null.
+//
+// As with IsZero, MarshalJSON has a value receiver so that Fields held by
+// non-addressable values are encoded the same way as addressable ones.
+func (f Field[T]) MarshalJSON() ([]byte, error) {
+	if !f.isSet || f.value.IsEmpty() {
+		return nilAsJSON, nil
+	}
+
+	return f.value.MarshalJSON()
+}
+
+// UnmarshalJSON populates this instance with the given JSON data and marks it as set.
+// It is only called by encoding/json if the key is present, so a missing key leaves this instance unset.
+func (f *Field[T]) UnmarshalJSON(data []byte) error {
+	if f == nil {
+		return ErrMutationOnNil
+	}
+
+	if err := f.value.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	f.isSet = true
+
+	return nil
+}
+
+// String returns the string representation of this instance.
+func (f *Field[T]) String() string {
+	if !f.IsSet() {
+		return "Field.unset"
+	}
+
+	if f.IsNull() {
+		return "Field.null"
+	}
+
+	return spew.Sprintf("Field[%#+v]", f.value.Unwrap())
+}
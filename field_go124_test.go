@@ -0,0 +1,32 @@
+//go:build go1.24
+
+package goptional
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestField_MarshalJSON_OmitZero(t *testing.T) {
+	type patch struct {
+		Name Field[string] `json:"name,omitzero"`
+		Age  Field[int]    `json:"age,omitzero"`
+		Tags Field[[]int]  `json:"tags,omitzero"`
+	}
+
+	jsonBytes, err := json.Marshal(&patch{Name: *FieldOf("gm"), Age: *Null[int]()})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"gm","age":null}`, string(jsonBytes))
+
+	byValue, err := json.Marshal(patch{Name: *FieldOf("gm"), Age: *Null[int]()})
+	require.NoError(t, err)
+	require.JSONEq(t, string(jsonBytes), string(byValue))
+
+	var p patch
+	require.NoError(t, json.Unmarshal(jsonBytes, &p))
+	require.True(t, p.Name.IsPresent())
+	require.True(t, p.Age.IsNull())
+	require.False(t, p.Tags.IsSet())
+}
@@ -0,0 +1,131 @@
+package goptional
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type samplePatch struct {
+	Name  Field[string]   `json:"name"`
+	Age   Field[int]      `json:"age"`
+	Tags  Field[[]string] `json:"tags"`
+	Other string          `json:"other"`
+}
+
+func TestField_Constructors(t *testing.T) {
+	f := Unset[int]()
+	require.False(t, f.IsSet())
+	require.False(t, f.IsNull())
+	require.False(t, f.IsPresent())
+	require.True(t, f.IsZero())
+
+	f = Null[int]()
+	require.True(t, f.IsSet())
+	require.True(t, f.IsNull())
+	require.False(t, f.IsPresent())
+	require.False(t, f.IsZero())
+
+	f = FieldOf(0)
+	require.True(t, f.IsSet())
+	require.False(t, f.IsNull())
+	require.True(t, f.IsPresent())
+	require.EqualValues(t, f.Optional().Unwrap(), 0)
+
+	require.True(t, FieldOf[[]string](nil).IsNull())
+	require.True(t, FieldFrom(Empty[int]()).IsNull())
+	require.True(t, FieldFrom[int](nil).IsNull())
+	require.EqualValues(t, FieldFrom(Of(123)).Optional().Unwrap(), 123)
+}
+
+func TestField_Nil(t *testing.T) {
+	var f *Field[int]
+	require.False(t, f.IsSet())
+	require.False(t, f.IsNull())
+	require.False(t, f.IsPresent())
+	require.True(t, f.Optional().IsEmpty())
+	require.ErrorIs(t, f.UnmarshalJSON([]byte("123")), ErrMutationOnNil)
+}
+
+func TestField_ZeroInst(t *testing.T) {
+	var f Field[string]
+	require.False(t, f.IsSet())
+	require.True(t, f.Optional().IsEmpty())
+}
+
+func TestField_OptionalIsDetached(t *testing.T) {
+	f := FieldOf(123)
+	o := f.Optional()
+	_, _ = o.Replace(321)
+	require.EqualValues(t, f.Optional().Unwrap(), 123)
+}
+
+func TestField_MarshalJSON(t *testing.T) {
+	jsonBytes, err := Unset[int]().MarshalJSON()
+	require.NoError(t, err)
+	require.EqualValues(t, jsonBytes, nilAsJSON)
+
+	jsonBytes, err = Null[int]().MarshalJSON()
+	require.NoError(t, err)
+	require.EqualValues(t, jsonBytes, nilAsJSON)
+
+	jsonBytes, err = FieldOf("gmgn").MarshalJSON()
+	require.NoError(t, err)
+	require.EqualValues(t, jsonBytes, []byte("\"gmgn\""))
+
+	jsonBytes, err = json.Marshal(&samplePatch{Name: *FieldOf("gm"), Age: *Null[int]()})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"gm","age":null,"tags":null,"other":""}`, string(jsonBytes))
+}
+
+func TestField_MarshalJSON_NonAddressable(t *testing.T) {
+	jsonBytes, err := json.Marshal(samplePatch{Name: *FieldOf("gm"), Age: *Null[int]()})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"gm","age":null,"tags":null,"other":""}`, string(jsonBytes))
+
+	jsonBytes, err = json.Marshal(map[string]Field[int]{"a": *FieldOf(1), "b": *Null[int](), "c": *Unset[int]()})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"a":1,"b":null,"c":null}`, string(jsonBytes))
+
+	jsonBytes, err = json.Marshal([]Field[string]{*FieldOf("gm"), *Null[string]()})
+	require.NoError(t, err)
+	require.JSONEq(t, `["gm",null]`, string(jsonBytes))
+}
+
+func TestField_UnmarshalJSON(t *testing.T) {
+	var p samplePatch
+	err := json.Unmarshal([]byte(`{"name":"gm","age":null}`), &p)
+	require.NoError(t, err)
+
+	require.True(t, p.Name.IsPresent())
+	require.EqualValues(t, p.Name.Optional().Unwrap(), "gm")
+
+	require.True(t, p.Age.IsSet())
+	require.True(t, p.Age.IsNull())
+
+	require.False(t, p.Tags.IsSet())
+	require.True(t, p.Tags.Optional().IsEmpty())
+}
+
+func TestField_UnmarshalJSON_InvalidData(t *testing.T) {
+	var p samplePatch
+	err := json.Unmarshal([]byte(`{"age":"gm"}`), &p)
+	require.Error(t, err)
+	require.False(t, p.Age.IsSet())
+}
+
+func TestField_UnmarshalJSON_ResetsValue(t *testing.T) {
+	f := FieldOf(123)
+	require.NoError(t, f.UnmarshalJSON(nilAsJSON))
+	require.True(t, f.IsNull())
+
+	require.NoError(t, f.UnmarshalJSON([]byte("321")))
+	require.EqualValues(t, f.Optional().Unwrap(), 321)
+}
+
+func TestField_String(t *testing.T) {
+	require.EqualValues(t, Unset[int]().String(), "Field.unset")
+	require.EqualValues(t, Null[int]().String(), "Field.null")
+	require.EqualValues(t, FieldOf(123).String(), "Field[(int)123]")
+}
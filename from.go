@@ -0,0 +1,45 @@
+package goptional
+
+import "os"
+
+// FromOk returns a new Optional from the comma-ok idiom, e.g. FromOk(cache.Get(key)).
+// It returns an empty Optional if ok is false, or if value is either invalid or nil, as Of would.
+func FromOk[T any](value T, ok bool) *Optional[T] {
+	if !ok {
+		return Empty[T]()
+	}
+
+	return Of(value)
+}
+
+// FromErr returns a new Optional from the (value, error) idiom, e.g. FromErr(strconv.Atoi(s)).
+// It returns an empty Optional if err is not nil, or if value is either invalid or nil, as Of would.
+// The error itself is discarded: use ResultOf to keep it.
+func FromErr[T any](value T, err error) *Optional[T] {
+	if err != nil {
+		return Empty[T]()
+	}
+
+	return Of(value)
+}
+
+// FromMap returns a new Optional holding the value associated with key in m.
+// It returns an empty Optional if m is nil, if key is missing, or if the associated value is nil.
+func FromMap[K comparable, V any](m map[K]V, key K) *Optional[V] {
+	v, ok := m[key]
+	return FromOk(v, ok)
+}
+
+// FromAssert returns a new Optional holding value asserted to type T.
+// It returns an empty Optional if value is nil, or if it does not hold a T.
+func FromAssert[T any](value interface{}) *Optional[T] {
+	v, ok := value.(T)
+	return FromOk(v, ok)
+}
+
+// FromLookupEnv returns a new Optional holding the value of the environment variable named by key.
+// It returns an empty Optional if the variable is not set.
+// A variable that is set to the empty string results in an Optional holding "".
+func FromLookupEnv(key string) *Optional[string] {
+	return FromOk(os.LookupEnv(key))
+}
@@ -0,0 +1,70 @@
+package goptional
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromOk(t *testing.T) {
+	require.EqualValues(t, FromOk(123, true).Unwrap(), 123)
+	require.EqualValues(t, FromOk(0, true).Unwrap(), 0)
+	require.True(t, FromOk(123, false).IsEmpty())
+	require.True(t, FromOk[*int](nil, true).IsEmpty())
+
+	ch := make(chan int, 1)
+	ch <- 123
+	close(ch)
+	v, ok := <-ch
+	require.EqualValues(t, FromOk(v, ok).Unwrap(), 123)
+	v, ok = <-ch
+	require.True(t, FromOk(v, ok).IsEmpty())
+}
+
+func TestFromErr(t *testing.T) {
+	require.EqualValues(t, FromErr(strconv.Atoi("123")).Unwrap(), 123)
+	require.True(t, FromErr(strconv.Atoi("gm")).IsEmpty())
+	require.True(t, FromErr(123, errors.New("woops")).IsEmpty())
+	require.True(t, FromErr[[]int](nil, nil).IsEmpty())
+}
+
+func TestFromMap(t *testing.T) {
+	m := map[string]*int{"nil": nil}
+	n := 123
+	m["gm"] = &n
+
+	require.EqualValues(t, FromMap(m, "gm").Unwrap(), &n)
+	require.True(t, FromMap(m, "nil").IsEmpty())
+	require.True(t, FromMap(m, "missing").IsEmpty())
+
+	var nilMap map[int]string
+	require.True(t, FromMap(nilMap, 1).IsEmpty())
+
+	require.EqualValues(t, FromMap(map[int]string{1: ""}, 1).Unwrap(), "")
+}
+
+func TestFromAssert(t *testing.T) {
+	var v interface{} = 123
+	require.EqualValues(t, FromAssert[int](v).Unwrap(), 123)
+	require.True(t, FromAssert[string](v).IsEmpty())
+	require.True(t, FromAssert[int](nil).IsEmpty())
+
+	var s fmt.Stringer = Of(123)
+	require.True(t, FromAssert[fmt.Stringer](s).IsPresent())
+	require.True(t, FromAssert[error](s).IsEmpty())
+
+	var nilPtr *sampleStruct
+	require.True(t, FromAssert[*sampleStruct](nilPtr).IsEmpty())
+}
+
+func TestFromLookupEnv(t *testing.T) {
+	t.Setenv("GOPTIONAL_SET", "gm")
+	t.Setenv("GOPTIONAL_BLANK", "")
+
+	require.EqualValues(t, FromLookupEnv("GOPTIONAL_SET").Unwrap(), "gm")
+	require.EqualValues(t, FromLookupEnv("GOPTIONAL_BLANK").Unwrap(), "")
+	require.True(t, FromLookupEnv("GOPTIONAL_MISSING_ENV_VAR").IsEmpty())
+}
@@ -0,0 +1,247 @@
+package goptional
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ErrFetchPanicked indicates that the fetch function of a Future panicked.
+var ErrFetchPanicked = errors.New("fetch panicked")
+
+// Future is an Optional being computed by a goroutine.
+// It resolves once, either to the Optional returned by its fetch function,
+// or to an empty Optional if the fetch fails or its context is done first.
+// In the latter cases, the cause is available through Err.
+type Future[T any] struct {
+	done   chan struct{}
+	cancel context.CancelFunc
+	value  *Optional[T]
+	err    error
+}
+
+// Async runs the given fetch function in a new goroutine and returns a Future for its result.
+// The value is held as Of would, so a nil value results in an empty Optional.
+//
+// The context passed to fetch is derived from ctx: it is done when ctx is, or when the Future is cancelled.
+// The Future resolves as soon as that happens, even if fetch has not returned yet.
+//
+// If fetch panics, the Future resolves to an empty Optional with an error wrapping ErrFetchPanicked.
+// A nil fetch resolves it to an empty Optional right away.
+func Async[T any](ctx context.Context, fetch func(context.Context) (T, error)) *Future[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	f := &Future[T]{
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+
+	if fetch == nil {
+		f.value = Empty[T]()
+		close(f.done)
+		cancel()
+		return f
+	}
+
+	type outcome struct {
+		value T
+		err   error
+	}
+	results := make(chan outcome, 1)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				results <- outcome{err: fmt.Errorf("%w: %v", ErrFetchPanicked, r)}
+			}
+		}()
+
+		value, err := fetch(ctx)
+		results <- outcome{value: value, err: err}
+	}()
+
+	go func() {
+		defer cancel()
+		defer close(f.done)
+
+		select {
+		case r := <-results:
+			if r.err != nil {
+				f.err = r.err
+				return
+			}
+			f.value = Of(r.value)
+		case <-ctx.Done():
+			f.err = ctx.Err()
+		}
+	}()
+
+	return f
+}
+
+// AsyncTimeout behaves as Async, resolving to an empty Optional with context.DeadlineExceeded
+// if fetch does not return within the given timeout.
+func AsyncTimeout[T any](ctx context.Context, timeout time.Duration, fetch func(context.Context) (T, error)) *Future[T] {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	f := Async(ctx, fetch)
+
+	go func() {
+		<-f.done
+		cancel()
+	}()
+
+	return f
+}
+
+// Done returns a channel that is closed once this instance has resolved.
+// A nil Future is considered resolved.
+func (f *Future[T]) Done() <-chan struct{} {
+	if f == nil {
+		return closedChan
+	}
+
+	return f.done
+}
+
+// Cancel cancels the context of this instance, resolving it to an empty Optional with context.Canceled
+// unless it has already resolved.
+func (f *Future[T]) Cancel() {
+	if f == nil {
+		return
+	}
+
+	f.cancel()
+}
+
+// Await blocks until this instance has resolved, and returns its Optional together with the cause of its emptiness, if any.
+// It returns an empty Optional and a nil error if this instance is nil.
+func (f *Future[T]) Await() (*Optional[T], error) {
+	if f == nil {
+		return Empty[T](), nil
+	}
+
+	<-f.done
+	return snapshot(f.value), f.err
+}
+
+// AwaitCtx behaves as Await, returning an empty Optional and ctx.Err() if ctx is done first.
+// A resolved instance always wins over a done ctx.
+// This instance keeps running in that case.
+func (f *Future[T]) AwaitCtx(ctx context.Context) (*Optional[T], error) {
+	if f.isResolved() {
+		return f.Await()
+	}
+
+	select {
+	case <-f.Done():
+		return f.Await()
+	case <-ctx.Done():
+		// Both may be ready at once, in which case select picks either.
+		if f.isResolved() {
+			return f.Await()
+		}
+		return Empty[T](), ctx.Err()
+	}
+}
+
+// Err blocks until this instance has resolved, and returns the cause of its emptiness, if any.
+func (f *Future[T]) Err() error {
+	_, err := f.Await()
+	return err
+}
+
+// isResolved returns true if this instance has resolved, without blocking.
+func (f *Future[T]) isResolved() bool {
+	select {
+	case <-f.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// poll returns the Optional of this instance and the cause of its emptiness if it has resolved,
+// or an empty Optional and a nil error otherwise, without blocking.
+func (f *Future[T]) poll() (*Optional[T], error) {
+	if f.isResolved() {
+		return f.Await()
+	}
+
+	return Empty[T](), nil
+}
+
+// AwaitAll blocks until all the given futures have resolved, and returns their Optionals in the same order,
+// together with the first non-nil cause among them, in the same order.
+// If ctx is done before all of them have resolved, the Optionals of the futures that have not resolved yet are empty,
+// and ctx.Err() is returned.
+func AwaitAll[T any](ctx context.Context, futures ...*Future[T]) ([]*Optional[T], error) {
+	results := make([]*Optional[T], len(futures))
+	var firstErr error
+
+	for i, f := range futures {
+		if _, err := f.AwaitCtx(ctx); !f.isResolved() {
+			// ctx is done first: collect whatever has resolved in the meantime.
+			for j := i; j < len(futures); j++ {
+				results[j], _ = futures[j].poll()
+			}
+			return results, err
+		}
+
+		o, err := f.Await()
+		results[i] = o
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return results, firstErr
+}
+
+// AwaitFirstPresent blocks until any of the given futures resolves to a non-empty Optional, and returns it.
+// If all of them resolve to empty ones, it returns an empty Optional together with the first non-nil cause among them,
+// in the order they resolved.
+// If ctx is done first, it returns an empty Optional and ctx.Err(),
+// unless one of the futures has already resolved to a non-empty Optional.
+// The futures are not cancelled: use Cancel to stop the remaining ones.
+func AwaitFirstPresent[T any](ctx context.Context, futures ...*Future[T]) (*Optional[T], error) {
+	cases := make([]reflect.SelectCase, 0, len(futures)+1)
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())})
+	for _, f := range futures {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(f.Done())})
+	}
+
+	var firstErr error
+	for pending := len(futures); pending > 0; pending-- {
+		i, _, _ := reflect.Select(cases)
+		if i == 0 {
+			// Several cases may be ready at once, in which case Select picks either:
+			// a future that has already resolved to a value wins over ctx.
+			for _, f := range futures {
+				if o, _ := f.poll(); o.IsPresent() {
+					return o, nil
+				}
+			}
+			return Empty[T](), ctx.Err()
+		}
+
+		o, err := futures[i-1].Await()
+		if o.IsPresent() {
+			return o, nil
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+
+		// A nil channel is never ready: stop selecting this future.
+		cases[i].Chan = reflect.ValueOf((<-chan struct{})(nil))
+	}
+
+	return Empty[T](), firstErr
+}
+
+var closedChan = func() chan struct{} {
+	c := make(chan struct{})
+	close(c)
+	return c
+}()
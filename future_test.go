@@ -0,0 +1,321 @@
+package goptional
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fetchAfter[T any](d time.Duration, value T, err error) func(context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		select {
+		case <-time.After(d):
+			return value, err
+		case <-ctx.Done():
+			return getZeroOfType[T](), ctx.Err()
+		}
+	}
+}
+
+func TestAsync_Value(t *testing.T) {
+	f := Async(context.Background(), fetchAfter(0, 123, nil))
+
+	opt, err := f.Await()
+	require.NoError(t, err)
+	require.EqualValues(t, opt.Unwrap(), 123)
+	require.NoError(t, f.Err())
+
+	<-f.Done()
+}
+
+func TestAsync_NilValue(t *testing.T) {
+	f := Async(context.Background(), fetchAfter[*int](0, nil, nil))
+
+	opt, err := f.Await()
+	require.NoError(t, err)
+	require.True(t, opt.IsEmpty())
+}
+
+func TestAsync_Error(t *testing.T) {
+	errFetch := errors.New("fetch")
+	f := Async(context.Background(), fetchAfter(0, 123, errFetch))
+
+	opt, err := f.Await()
+	require.ErrorIs(t, err, errFetch)
+	require.True(t, opt.IsEmpty())
+}
+
+func TestAsync_Panic(t *testing.T) {
+	f := Async(context.Background(), func(context.Context) (int, error) {
+		panic("fetch")
+	})
+
+	opt, err := f.Await()
+	require.ErrorIs(t, err, ErrFetchPanicked)
+	require.Contains(t, err.Error(), "fetch")
+	require.True(t, opt.IsEmpty())
+}
+
+func TestAsync_NilFetch(t *testing.T) {
+	f := Async[int](context.Background(), nil)
+
+	opt, err := f.Await()
+	require.NoError(t, err)
+	require.True(t, opt.IsEmpty())
+
+	f = AsyncTimeout[int](context.Background(), time.Hour, nil)
+	opt, err = f.Await()
+	require.NoError(t, err)
+	require.True(t, opt.IsEmpty())
+}
+
+func TestAsync_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	f := Async(ctx, fetchAfter(time.Hour, 123, nil))
+	cancel()
+
+	opt, err := f.Await()
+	require.ErrorIs(t, err, context.Canceled)
+	require.True(t, opt.IsEmpty())
+}
+
+func TestAsync_IgnoresContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	f := Async(ctx, func(context.Context) (int, error) {
+		<-release
+		return 1, nil
+	})
+	cancel()
+
+	opt, err := f.Await()
+	require.ErrorIs(t, err, context.Canceled)
+	require.True(t, opt.IsEmpty())
+	close(release)
+}
+
+func TestFuture_Cancel(t *testing.T) {
+	f := Async(context.Background(), fetchAfter(time.Hour, 123, nil))
+	f.Cancel()
+
+	opt, err := f.Await()
+	require.ErrorIs(t, err, context.Canceled)
+	require.True(t, opt.IsEmpty())
+
+	done := Async(context.Background(), fetchAfter(0, 1, nil))
+	_, _ = done.Await()
+	done.Cancel()
+
+	opt, err = done.Await()
+	require.NoError(t, err)
+	require.EqualValues(t, opt.Unwrap(), 1)
+}
+
+func TestAsyncTimeout(t *testing.T) {
+	f := AsyncTimeout(context.Background(), 10*time.Millisecond, fetchAfter(time.Hour, 123, nil))
+
+	opt, err := f.Await()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.True(t, opt.IsEmpty())
+
+	f = AsyncTimeout(context.Background(), time.Hour, fetchAfter(0, 123, nil))
+
+	opt, err = f.Await()
+	require.NoError(t, err)
+	require.EqualValues(t, opt.Unwrap(), 123)
+}
+
+func TestFuture_AwaitCtx(t *testing.T) {
+	f := Async(context.Background(), fetchAfter(time.Hour, 123, nil))
+	defer f.Cancel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	opt, err := f.AwaitCtx(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.True(t, opt.IsEmpty())
+
+	select {
+	case <-f.Done():
+		t.Fatal("the future should keep running")
+	default:
+	}
+}
+
+func TestFuture_Nil(t *testing.T) {
+	var f *Future[int]
+
+	opt, err := f.Await()
+	require.NoError(t, err)
+	require.True(t, opt.IsEmpty())
+
+	opt, err = f.AwaitCtx(context.Background())
+	require.NoError(t, err)
+	require.True(t, opt.IsEmpty())
+
+	f.Cancel()
+	<-f.Done()
+}
+
+func TestFuture_Snapshots(t *testing.T) {
+	f := Async(context.Background(), fetchAfter(0, 1, nil))
+	opt, _ := f.Await()
+	opt.Take()
+
+	opt, _ = f.Await()
+	require.EqualValues(t, opt.Unwrap(), 1)
+}
+
+func TestAwaitAll(t *testing.T) {
+	errFetch := errors.New("fetch")
+	ctx := context.Background()
+
+	results, err := AwaitAll(ctx,
+		Async(ctx, fetchAfter(5*time.Millisecond, 1, nil)),
+		Async(ctx, fetchAfter(0, 2, errFetch)),
+		Async(ctx, fetchAfter(0, 3, nil)),
+	)
+	require.ErrorIs(t, err, errFetch)
+	require.Len(t, results, 3)
+	require.EqualValues(t, results[0].Unwrap(), 1)
+	require.True(t, results[1].IsEmpty())
+	require.EqualValues(t, results[2].Unwrap(), 3)
+
+	sum := ZipWith(results[0], results[2], func(a, b int) int { return a + b })
+	require.EqualValues(t, sum.Unwrap(), 4)
+
+	results, err = AwaitAll[int](ctx)
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
+func TestAwaitAll_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	slow := Async(context.Background(), fetchAfter(time.Hour, 2, nil))
+	defer slow.Cancel()
+	slower := Async(context.Background(), fetchAfter(time.Hour, 4, nil))
+	defer slower.Cancel()
+
+	resolved := Async(context.Background(), fetchAfter(0, 3, nil))
+	<-resolved.Done()
+
+	results, err := AwaitAll(ctx,
+		Async(ctx, fetchAfter(0, 1, nil)),
+		slow,
+		resolved,
+		slower,
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Len(t, results, 4)
+	require.EqualValues(t, results[0].Unwrap(), 1)
+	require.True(t, results[1].IsEmpty())
+	require.EqualValues(t, results[2].Unwrap(), 3)
+	require.True(t, results[3].IsEmpty())
+}
+
+func TestFuture_AwaitCtx_ResolvedWinsOverDoneContext(t *testing.T) {
+	resolved := Async(context.Background(), fetchAfter(0, 1, nil))
+	<-resolved.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for i := 0; i < 1000; i++ {
+		opt, err := resolved.AwaitCtx(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, opt.Unwrap(), 1)
+	}
+}
+
+func TestAwaitAll_ResolvedWithCancelledContext(t *testing.T) {
+	first := Async(context.Background(), fetchAfter(0, 1, nil))
+	second := Async(context.Background(), fetchAfter(0, 2, nil))
+	<-first.Done()
+	<-second.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for i := 0; i < 1000; i++ {
+		results, err := AwaitAll(ctx, first, second)
+		require.NoError(t, err)
+		require.EqualValues(t, results[0].Unwrap(), 1)
+		require.EqualValues(t, results[1].Unwrap(), 2)
+	}
+
+	pending := Async(context.Background(), fetchAfter(time.Hour, 3, nil))
+	defer pending.Cancel()
+
+	for i := 0; i < 1000; i++ {
+		results, err := AwaitAll(ctx, first, pending, second)
+		require.ErrorIs(t, err, context.Canceled)
+		require.EqualValues(t, results[0].Unwrap(), 1)
+		require.True(t, results[1].IsEmpty())
+		require.EqualValues(t, results[2].Unwrap(), 2)
+	}
+}
+
+func TestAwaitFirstPresent(t *testing.T) {
+	ctx := context.Background()
+
+	one, three := 1, 3
+
+	slow := Async(ctx, fetchAfter(time.Hour, &one, nil))
+	defer slow.Cancel()
+
+	opt, err := AwaitFirstPresent(ctx,
+		slow,
+		Async(ctx, fetchAfter[*int](0, nil, nil)),
+		Async(ctx, fetchAfter(5*time.Millisecond, &three, nil)),
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, *opt.Unwrap(), 3)
+}
+
+func TestAwaitFirstPresent_AllEmpty(t *testing.T) {
+	errFetch := errors.New("fetch")
+	ctx := context.Background()
+
+	opt, err := AwaitFirstPresent(ctx,
+		Async(ctx, fetchAfter(0, 0, errFetch)),
+		Async(ctx, fetchAfter(5*time.Millisecond, 0, errFetch)),
+	)
+	require.ErrorIs(t, err, errFetch)
+	require.True(t, opt.IsEmpty())
+
+	opt, err = AwaitFirstPresent[int](ctx)
+	require.NoError(t, err)
+	require.True(t, opt.IsEmpty())
+}
+
+func TestAwaitFirstPresent_ResolvedWithCancelledContext(t *testing.T) {
+	resolved := Async(context.Background(), fetchAfter(0, 1, nil))
+	<-resolved.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for i := 0; i < 1000; i++ {
+		opt, err := AwaitFirstPresent(ctx, resolved)
+		require.NoError(t, err)
+		require.EqualValues(t, opt.Unwrap(), 1)
+	}
+}
+
+func TestAwaitFirstPresent_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	slow := Async(context.Background(), fetchAfter(time.Hour, 1, nil))
+	defer slow.Cancel()
+
+	opt, err := AwaitFirstPresent(ctx, slow)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.True(t, opt.IsEmpty())
+}
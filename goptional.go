@@ -29,6 +29,8 @@ func Empty[T any]() *Optional[T] {
 
 // Of attempts to return a new non-empty Optional wrapping the given value.
 // If such value is either invalid or nil, it returns an empty Optional instead.
+// For interface types, nil-ness is checked on the dynamic value, so a typed nil such as error((*MyErr)(nil))
+// results in an empty Optional too.
 func Of[T any](value T) *Optional[T] {
 	// There are two sensible implementation choices here: reflection and a Nillable interface.
 	// The third _undesirable_ choice would be to skip the IsNil check and
@@ -167,6 +169,36 @@ func FlatMap[X, Y any](input *Optional[X], mapper func(X) *Optional[Y]) *Optiona
 	return mapper(input.Unwrap())
 }
 
+// Fold returns one of the following:
+//   - the result of the application of onPresent to the value of input if input is not empty
+//   - the result of onEmpty if input is empty
+//
+// Unlike MapOrElse, the result is returned as is rather than wrapped into an Optional.
+// It returns the zero value of R if the function matching the state of input is nil.
+func Fold[T, R any](input *Optional[T], onPresent func(T) R, onEmpty func() R) R {
+	if input.IsEmpty() {
+		if onEmpty == nil {
+			return getZeroOfType[R]()
+		}
+		return onEmpty()
+	}
+
+	if onPresent == nil {
+		return getZeroOfType[R]()
+	}
+
+	return onPresent(input.Unwrap())
+}
+
+// Match returns the result of the application of onPresent to the value held by this instance, if any,
+// or the result of onEmpty otherwise.
+// Use Fold to compute a result of a type other than T.
+//
+// It returns the zero value of T if the function matching the state of this instance is nil.
+func (o *Optional[T]) Match(onPresent func(T) T, onEmpty func() T) T {
+	return Fold(o, onPresent, onEmpty)
+}
+
 // And returns one of the following:
 //   - this instance if it is empty
 //   - a new Optional provided by the given supplier
@@ -394,6 +426,8 @@ func Zip[X, Y any](o1 *Optional[X], o2 *Optional[Y]) *Optional[*Pair[X, Y]] {
 
 // Unzip unzips the given optional pair.
 // If o is not empty, it returns the unwrapped pair, or two empty Optionals otherwise.
+//
+// Note that Unzip expects a Pair of Optionals, which is not what Zip returns: use UnzipPair to invert Zip.
 func Unzip[X, Y any](o *Optional[*Pair[*Optional[X], *Optional[Y]]]) (*Optional[X], *Optional[Y]) {
 	if o.IsPresent() {
 		pair := o.Unwrap()
@@ -403,6 +437,23 @@ func Unzip[X, Y any](o *Optional[*Pair[*Optional[X], *Optional[Y]]]) (*Optional[
 	return Empty[X](), Empty[Y]()
 }
 
+// UnzipPair is the inverse of Zip.
+// If o is not empty, it returns two Optionals holding the elements of its Pair, or two empty Optionals otherwise.
+// As with Of, an element that is nil results in an empty Optional.
+//
+// For any o1, o2 & mapper, the following laws hold:
+//   - UnzipPair(Zip(o1, o2)) returns Optionals equal to o1 & o2 if both are non-empty, or two empty Optionals otherwise
+//   - Zip(UnzipPair(o)) is equal to o if the elements of its Pair are not nil
+//   - ZipWith(o1, o2, mapper) is equal to Map(Zip(o1, o2), func(p *Pair[X, Y]) Z { return mapper(p.First, p.Second) })
+func UnzipPair[X, Y any](o *Optional[*Pair[X, Y]]) (*Optional[X], *Optional[Y]) {
+	if o.IsPresent() {
+		pair := o.Unwrap()
+		return Of(pair.First), Of(pair.Second)
+	}
+
+	return Empty[X](), Empty[Y]()
+}
+
 // ZipWith zips o1 with o2.
 // If o1 and o2 are both non-empty, it returns an Optional with a value
 // that results from the application of the given mapper to the values of o1 & o2.
@@ -421,6 +472,112 @@ func ZipWith[X, Y, Z any](o1 *Optional[X], o2 *Optional[Y], mapper func(X, Y) Z)
 	return Empty[Z]()
 }
 
+// Triple is your usual generic triple.
+type Triple[X, Y, Z any] struct {
+	// First is the first element of the triple.
+	First X
+	// Second is the second element of the triple.
+	Second Y
+	// Third is the third element of the triple.
+	Third Z
+}
+
+// Quad is your usual generic quadruple.
+type Quad[X, Y, Z, W any] struct {
+	// First is the first element of the quadruple.
+	First X
+	// Second is the second element of the quadruple.
+	Second Y
+	// Third is the third element of the quadruple.
+	Third Z
+	// Fourth is the fourth element of the quadruple.
+	Fourth W
+}
+
+// Zip3 zips o1, o2 & o3.
+// If they are all non-empty, it returns an Optional Triple holding their values.
+//
+// It returns an empty Optional otherwise.
+func Zip3[X, Y, Z any](o1 *Optional[X], o2 *Optional[Y], o3 *Optional[Z]) *Optional[*Triple[X, Y, Z]] {
+	if o1.IsPresent() && o2.IsPresent() && o3.IsPresent() {
+		return Of(&Triple[X, Y, Z]{First: o1.Unwrap(), Second: o2.Unwrap(), Third: o3.Unwrap()})
+	}
+
+	return Empty[*Triple[X, Y, Z]]()
+}
+
+// Zip4 zips o1, o2, o3 & o4.
+// If they are all non-empty, it returns an Optional Quad holding their values.
+//
+// It returns an empty Optional otherwise.
+func Zip4[X, Y, Z, W any](o1 *Optional[X], o2 *Optional[Y], o3 *Optional[Z], o4 *Optional[W]) *Optional[*Quad[X, Y, Z, W]] {
+	if o1.IsPresent() && o2.IsPresent() && o3.IsPresent() && o4.IsPresent() {
+		return Of(&Quad[X, Y, Z, W]{First: o1.Unwrap(), Second: o2.Unwrap(), Third: o3.Unwrap(), Fourth: o4.Unwrap()})
+	}
+
+	return Empty[*Quad[X, Y, Z, W]]()
+}
+
+// ZipWith3 zips o1, o2 & o3.
+// If they are all non-empty, it returns an Optional with a value
+// that results from the application of the given mapper to their values.
+// It returns an empty Optional otherwise.
+//
+// If they are all non-empty and mapper is nil, it returns an empty Optional of the target type.
+func ZipWith3[X, Y, Z, R any](o1 *Optional[X], o2 *Optional[Y], o3 *Optional[Z], mapper func(X, Y, Z) R) *Optional[R] {
+	if o1.IsPresent() && o2.IsPresent() && o3.IsPresent() {
+		if mapper == nil {
+			return Empty[R]()
+		}
+
+		return Of(mapper(o1.Unwrap(), o2.Unwrap(), o3.Unwrap()))
+	}
+
+	return Empty[R]()
+}
+
+// ZipWith4 zips o1, o2, o3 & o4.
+// If they are all non-empty, it returns an Optional with a value
+// that results from the application of the given mapper to their values.
+// It returns an empty Optional otherwise.
+//
+// If they are all non-empty and mapper is nil, it returns an empty Optional of the target type.
+func ZipWith4[X, Y, Z, W, R any](o1 *Optional[X], o2 *Optional[Y], o3 *Optional[Z], o4 *Optional[W], mapper func(X, Y, Z, W) R) *Optional[R] {
+	if o1.IsPresent() && o2.IsPresent() && o3.IsPresent() && o4.IsPresent() {
+		if mapper == nil {
+			return Empty[R]()
+		}
+
+		return Of(mapper(o1.Unwrap(), o2.Unwrap(), o3.Unwrap(), o4.Unwrap()))
+	}
+
+	return Empty[R]()
+}
+
+// Unzip3 is the inverse of Zip3.
+// If o is not empty, it returns three Optionals holding the elements of its Triple, or three empty Optionals otherwise.
+// As with Of, an element that is nil results in an empty Optional.
+func Unzip3[X, Y, Z any](o *Optional[*Triple[X, Y, Z]]) (*Optional[X], *Optional[Y], *Optional[Z]) {
+	if o.IsPresent() {
+		triple := o.Unwrap()
+		return Of(triple.First), Of(triple.Second), Of(triple.Third)
+	}
+
+	return Empty[X](), Empty[Y](), Empty[Z]()
+}
+
+// Unzip4 is the inverse of Zip4.
+// If o is not empty, it returns four Optionals holding the elements of its Quad, or four empty Optionals otherwise.
+// As with Of, an element that is nil results in an empty Optional.
+func Unzip4[X, Y, Z, W any](o *Optional[*Quad[X, Y, Z, W]]) (*Optional[X], *Optional[Y], *Optional[Z], *Optional[W]) {
+	if o.IsPresent() {
+		quad := o.Unwrap()
+		return Of(quad.First), Of(quad.Second), Of(quad.Third), Of(quad.Fourth)
+	}
+
+	return Empty[X](), Empty[Y](), Empty[Z](), Empty[W]()
+}
+
 // Flatten flattens the given Optional.
 func Flatten[T any](o *Optional[*Optional[T]]) *Optional[T] {
 	if o.IsPresent() {
@@ -511,24 +668,6 @@ func (o *Optional[T]) unsetValue() {
 	o.isValueValid = false
 }
 
-// isValueValid returns false if the given value is either nil or invalid.
-func isValueValid[T any](value T) bool {
-	v := reflect.ValueOf(value)
-	if !v.IsValid() {
-		return false
-	}
-
-	switch v.Kind() {
-	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
-		if v.IsNil() {
-			return false
-		}
-		fallthrough
-	default:
-		return true
-	}
-}
-
 func getZeroOfType[T any]() T {
 	var zero T
 	return zero
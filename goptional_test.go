@@ -372,6 +372,38 @@ func TestMapOrElse_NilSupplierOnEmpty(t *testing.T) {
 	require.True(t, MapOrElse(Empty[string](), func(_ string) int { return 0 }, nil).IsEmpty())
 }
 
+func TestFold_NotEmpty(t *testing.T) {
+	v := Fold(Of(123), func(x int) string { return fmt.Sprintf("%v", x) }, func() string { return "default" })
+	require.EqualValues(t, v, "123")
+}
+
+func TestFold_Empty(t *testing.T) {
+	v := Fold(Empty[int](), func(x int) string { return fmt.Sprintf("%v", x) }, func() string { return "default" })
+	require.EqualValues(t, v, "default")
+}
+
+func TestFold_NilInput(t *testing.T) {
+	v := Fold(nil, func(x int) string { return fmt.Sprintf("%v", x) }, func() string { return "default" })
+	require.EqualValues(t, v, "default")
+}
+
+func TestFold_NilFuncs(t *testing.T) {
+	require.EqualValues(t, Fold[int, string](Of(123), nil, func() string { return "default" }), "")
+	require.EqualValues(t, Fold(Empty[int](), func(x int) string { return "present" }, nil), "")
+}
+
+func TestMatch(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+	fallback := func() int { return -1 }
+
+	require.EqualValues(t, Of(123).Match(double, fallback), 246)
+	require.EqualValues(t, Empty[int]().Match(double, fallback), -1)
+	require.EqualValues(t, Empty[int]().Match(double, nil), 0)
+
+	var opt *Optional[int]
+	require.EqualValues(t, opt.Match(double, fallback), -1)
+}
+
 func TestFlatMap_Empty(t *testing.T) {
 	opt := FlatMap(Empty[string](), func(_ string) *Optional[int] { return Of(123) })
 	require.True(t, opt.IsEmpty())
@@ -936,6 +968,57 @@ func TestUnzip_BothEmpty(t *testing.T) {
 	require.EqualValues(t, opt2, pair.Second)
 }
 
+func TestUnzipPair_Empty(t *testing.T) {
+	opt1, opt2 := UnzipPair(Empty[*Pair[int, string]]())
+	require.True(t, opt1.IsEmpty())
+	require.True(t, opt2.IsEmpty())
+
+	opt1, opt2 = UnzipPair[int, string](nil)
+	require.True(t, opt1.IsEmpty())
+	require.True(t, opt2.IsEmpty())
+}
+
+func TestUnzipPair_NotEmpty(t *testing.T) {
+	opt1, opt2 := UnzipPair(Of(&Pair[int, string]{First: 123, Second: "gm"}))
+	require.EqualValues(t, opt1.Unwrap(), 123)
+	require.EqualValues(t, opt2.Unwrap(), "gm")
+}
+
+func TestUnzipPair_NilElement(t *testing.T) {
+	opt1, opt2 := UnzipPair(Of(&Pair[*int, string]{Second: "gm"}))
+	require.True(t, opt1.IsEmpty())
+	require.EqualValues(t, opt2.Unwrap(), "gm")
+}
+
+func TestZipUnzipPair_Laws(t *testing.T) {
+	firsts := []*Optional[int]{Of(123), Of(0), Empty[int](), nil}
+	seconds := []*Optional[string]{Of("gm"), Of(""), Empty[string](), nil}
+	concat := func(x int, y string) string { return fmt.Sprintf("%v_%v", x, y) }
+
+	for _, o1 := range firsts {
+		for _, o2 := range seconds {
+			zipped := Zip(o1, o2)
+
+			// UnzipPair inverts Zip.
+			u1, u2 := UnzipPair(zipped)
+			if o1.IsPresent() && o2.IsPresent() {
+				require.True(t, u1.Equals(o1))
+				require.True(t, u2.Equals(o2))
+			} else {
+				require.True(t, u1.IsEmpty())
+				require.True(t, u2.IsEmpty())
+			}
+
+			// Zip inverts UnzipPair.
+			require.True(t, Zip(u1, u2).Equals(zipped))
+
+			// ZipWith is Zip followed by Map.
+			viaMap := Map(zipped, func(p *Pair[int, string]) string { return concat(p.First, p.Second) })
+			require.True(t, ZipWith(o1, o2, concat).Equals(viaMap))
+		}
+	}
+}
+
 func TestZipWith_SomeEmpty(t *testing.T) {
 	require.True(t, ZipWith[string, int, interface{}](Empty[string](), Empty[int](), nil).IsEmpty())
 	require.True(t, ZipWith[string, int, interface{}](Of("gm"), Empty[int](), nil).IsEmpty())
@@ -961,6 +1044,75 @@ func TestZipWith_BothNotEmptyWithNilReturn(t *testing.T) {
 	require.True(t, opt.IsEmpty())
 }
 
+func TestZip3_SomeEmpty(t *testing.T) {
+	require.True(t, Zip3(Empty[int](), Of("gm"), Of(true)).IsEmpty())
+	require.True(t, Zip3(Of(123), Empty[string](), Of(true)).IsEmpty())
+	require.True(t, Zip3(Of(123), Of("gm"), Empty[bool]()).IsEmpty())
+}
+
+func TestZip3_AllNotEmpty(t *testing.T) {
+	opt := Zip3(Of(123), Of("gm"), Of(true))
+	require.True(t, opt.IsPresent())
+	require.EqualValues(t, opt.Unwrap(), &Triple[int, string, bool]{First: 123, Second: "gm", Third: true})
+}
+
+func TestZip4_SomeEmpty(t *testing.T) {
+	require.True(t, Zip4(Empty[int](), Of("gm"), Of(true), Of(1.5)).IsEmpty())
+	require.True(t, Zip4(Of(123), Of("gm"), Of(true), Empty[float64]()).IsEmpty())
+}
+
+func TestZip4_AllNotEmpty(t *testing.T) {
+	opt := Zip4(Of(123), Of("gm"), Of(true), Of(1.5))
+	require.True(t, opt.IsPresent())
+	require.EqualValues(t, opt.Unwrap(), &Quad[int, string, bool, float64]{First: 123, Second: "gm", Third: true, Fourth: 1.5})
+}
+
+func TestZipWith3(t *testing.T) {
+	join := func(x int, y string, z bool) string { return fmt.Sprintf("%v_%v_%v", x, y, z) }
+
+	require.EqualValues(t, ZipWith3(Of(123), Of("gm"), Of(true), join).Unwrap(), "123_gm_true")
+	require.True(t, ZipWith3(Of(123), Empty[string](), Of(true), join).IsEmpty())
+	require.True(t, ZipWith3[int, string, bool, string](Of(123), Of("gm"), Of(true), nil).IsEmpty())
+}
+
+func TestZipWith4(t *testing.T) {
+	join := func(x int, y string, z bool, w float64) string { return fmt.Sprintf("%v_%v_%v_%v", x, y, z, w) }
+
+	require.EqualValues(t, ZipWith4(Of(123), Of("gm"), Of(true), Of(1.5), join).Unwrap(), "123_gm_true_1.5")
+	require.True(t, ZipWith4(Of(123), Of("gm"), Of(true), Empty[float64](), join).IsEmpty())
+	require.True(t, ZipWith4[int, string, bool, float64, string](Of(123), Of("gm"), Of(true), Of(1.5), nil).IsEmpty())
+	require.True(t, ZipWith4(Of(123), Of("gm"), Of(true), Of(1.5), func(int, string, bool, float64) []int { return nil }).IsEmpty())
+}
+
+func TestUnzip3(t *testing.T) {
+	opt1, opt2, opt3 := Unzip3(Zip3(Of(123), Of("gm"), Of(true)))
+	require.EqualValues(t, opt1.Unwrap(), 123)
+	require.EqualValues(t, opt2.Unwrap(), "gm")
+	require.EqualValues(t, opt3.Unwrap(), true)
+
+	opt1, opt2, opt3 = Unzip3(Empty[*Triple[int, string, bool]]())
+	require.True(t, opt1.IsEmpty())
+	require.True(t, opt2.IsEmpty())
+	require.True(t, opt3.IsEmpty())
+
+	optPtr, _, _ := Unzip3(Of(&Triple[*int, string, bool]{Second: "gm"}))
+	require.True(t, optPtr.IsEmpty())
+}
+
+func TestUnzip4(t *testing.T) {
+	opt1, opt2, opt3, opt4 := Unzip4(Zip4(Of(123), Of("gm"), Of(true), Of(1.5)))
+	require.EqualValues(t, opt1.Unwrap(), 123)
+	require.EqualValues(t, opt2.Unwrap(), "gm")
+	require.EqualValues(t, opt3.Unwrap(), true)
+	require.EqualValues(t, opt4.Unwrap(), 1.5)
+
+	opt1, opt2, opt3, opt4 = Unzip4[int, string, bool, float64](nil)
+	require.True(t, opt1.IsEmpty())
+	require.True(t, opt2.IsEmpty())
+	require.True(t, opt3.IsEmpty())
+	require.True(t, opt4.IsEmpty())
+}
+
 func TestIs_Empty(t *testing.T) {
 	require.False(t, Empty[int]().Is(nil))
 	require.False(t, Empty[int]().Is(func(_ int) bool { return true }))
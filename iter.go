@@ -0,0 +1,89 @@
+//go:build go1.23
+
+package goptional
+
+import "iter"
+
+// All returns an iterator that yields the value of this instance once if it is not empty, and never otherwise.
+// It allows an Optional to be ranged over and to be fed to functions such as slices.Collect.
+func (o *Optional[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.IsPresent() {
+			yield(o.Unwrap())
+		}
+	}
+}
+
+// All2 returns an iterator that yields the elements of the pair held by o once if o is not empty, and never otherwise.
+// It allows the output of Zip to be ranged over with two loop variables.
+func All2[X, Y any](o *Optional[*Pair[X, Y]]) iter.Seq2[X, Y] {
+	return func(yield func(X, Y) bool) {
+		if o.IsPresent() && o.Unwrap() != nil {
+			pair := o.Unwrap()
+			yield(pair.First, pair.Second)
+		}
+	}
+}
+
+// Values returns an iterator over the values of the non-empty Optionals yielded by seq, skipping empty ones.
+// If seq is nil, the returned iterator yields nothing.
+func Values[T any](seq iter.Seq[*Optional[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if seq == nil {
+			return
+		}
+
+		for o := range seq {
+			if o.IsPresent() && !yield(o.Unwrap()) {
+				return
+			}
+		}
+	}
+}
+
+// First returns an Optional holding the first value yielded by seq, if any, or an empty Optional otherwise.
+// As with Of, the Optional is empty if such value is either invalid or nil.
+func First[T any](seq iter.Seq[T]) *Optional[T] {
+	if seq == nil {
+		return Empty[T]()
+	}
+
+	for v := range seq {
+		return Of(v)
+	}
+
+	return Empty[T]()
+}
+
+// Last returns an Optional holding the last value yielded by seq, if any, or an empty Optional otherwise.
+// As with Of, the Optional is empty if such value is either invalid or nil.
+func Last[T any](seq iter.Seq[T]) *Optional[T] {
+	if seq == nil {
+		return Empty[T]()
+	}
+
+	last := Empty[T]()
+	for v := range seq {
+		last = Of(v)
+	}
+
+	return last
+}
+
+// Find returns an Optional holding the first value yielded by seq that satisfies the given predicate,
+// or an empty Optional if there is no such value.
+//
+// If seq or predicate are nil, it returns an empty Optional.
+func Find[T any](seq iter.Seq[T], predicate func(T) bool) *Optional[T] {
+	if seq == nil || predicate == nil {
+		return Empty[T]()
+	}
+
+	for v := range seq {
+		if predicate(v) {
+			return Of(v)
+		}
+	}
+
+	return Empty[T]()
+}
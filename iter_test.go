@@ -0,0 +1,77 @@
+//go:build go1.23
+
+package goptional
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAll_NotEmpty(t *testing.T) {
+	count := 0
+	for v := range Of(123).All() {
+		require.EqualValues(t, v, 123)
+		count++
+	}
+	require.EqualValues(t, count, 1)
+
+	require.EqualValues(t, slices.Collect(Of("gm").All()), []string{"gm"})
+}
+
+func TestAll_Empty(t *testing.T) {
+	for range Empty[int]().All() {
+		require.Fail(t, "must not yield")
+	}
+
+	var opt *Optional[int]
+	require.Empty(t, slices.Collect(opt.All()))
+}
+
+func TestAll2(t *testing.T) {
+	m := maps.Collect(All2(Zip(Of("gm"), Of(123))))
+	require.EqualValues(t, m, map[string]int{"gm": 123})
+
+	for range All2(Zip(Empty[string](), Of(123))) {
+		require.Fail(t, "must not yield")
+	}
+}
+
+func TestValues(t *testing.T) {
+	opts := []*Optional[int]{Of(1), Empty[int](), nil, Of(2), Of(3)}
+	require.EqualValues(t, slices.Collect(Values(slices.Values(opts))), []int{1, 2, 3})
+
+	for v := range Values(slices.Values(opts)) {
+		require.EqualValues(t, v, 1)
+		break
+	}
+
+	require.Empty(t, slices.Collect(Values[int](nil)))
+}
+
+func TestFirst(t *testing.T) {
+	require.EqualValues(t, First(slices.Values([]int{1, 2, 3})).Unwrap(), 1)
+	require.True(t, First(slices.Values([]int{})).IsEmpty())
+	require.True(t, First(slices.Values([]*int{nil})).IsEmpty())
+	require.True(t, First[int](nil).IsEmpty())
+}
+
+func TestLast(t *testing.T) {
+	require.EqualValues(t, Last(slices.Values([]int{1, 2, 3})).Unwrap(), 3)
+	require.True(t, Last(slices.Values([]int{})).IsEmpty())
+	require.True(t, Last[int](nil).IsEmpty())
+}
+
+func TestFind(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	require.EqualValues(t, Find(slices.Values([]int{1, 2, 3, 4}), isEven).Unwrap(), 2)
+	require.True(t, Find(slices.Values([]int{1, 3}), isEven).IsEmpty())
+	require.True(t, Find(slices.Values([]int{1, 2}), nil).IsEmpty())
+	require.True(t, Find(nil, isEven).IsEmpty())
+
+	v := Find(maps.Keys(map[string]int{"gm": 1}), func(s string) bool { return s == "gm" })
+	require.EqualValues(t, v.Unwrap(), "gm")
+}
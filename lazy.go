@@ -0,0 +1,170 @@
+package goptional
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// Lazy is an Optional whose value is computed on demand.
+// Filter, MapLazy and FlatMapLazy record steps without running them:
+// the whole chain is evaluated on the first terminal operation (e.g. OrElse, Val, IfPresent),
+// and its result is memoized for later ones, also across goroutines.
+// If the evaluation panics, every terminal operation panics with the same value.
+//
+// A nil *Lazy[T] is considered empty.
+type Lazy[T any] struct {
+	once      sync.Once
+	done      atomic.Bool
+	eval      func() *Optional[T]
+	result    *Optional[T]
+	panicked  bool
+	recovered interface{}
+}
+
+// LazyOf returns a new Lazy holding the value returned by the given supplier, as Of would.
+// A nil supplier results in an empty Lazy.
+func LazyOf[T any](supplier func() T) *Lazy[T] {
+	if supplier == nil {
+		return LazyFrom[T](nil)
+	}
+
+	return LazyFrom(func() *Optional[T] {
+		return Of(supplier())
+	})
+}
+
+// LazyFrom returns a new Lazy holding the Optional returned by the given supplier.
+// A nil supplier results in an empty Lazy.
+func LazyFrom[T any](supplier func() *Optional[T]) *Lazy[T] {
+	return &Lazy[T]{eval: supplier}
+}
+
+// Get evaluates this instance, if not done yet, and returns its result.
+func (l *Lazy[T]) Get() *Optional[T] {
+	if l == nil {
+		return Empty[T]()
+	}
+
+	l.once.Do(l.evaluate)
+
+	if l.panicked {
+		panic(l.recovered)
+	}
+
+	return snapshot(l.result)
+}
+
+// IsEvaluated returns true if this instance has already been evaluated, without evaluating it.
+func (l *Lazy[T]) IsEvaluated() bool {
+	return l == nil || l.done.Load()
+}
+
+func (l *Lazy[T]) evaluate() {
+	defer l.done.Store(true)
+
+	defer func() {
+		// Drop the chain so that it can be garbage collected.
+		l.eval = nil
+
+		if r := recover(); r != nil {
+			l.panicked = true
+			l.recovered = r
+		}
+	}()
+
+	if l.eval != nil {
+		l.result = l.eval()
+	}
+}
+
+// Filter returns a new Lazy that, once evaluated, holds the value of this instance
+// if it satisfies the given predicate, or is empty otherwise.
+func (l *Lazy[T]) Filter(predicate func(T) bool) *Lazy[T] {
+	return LazyFrom(func() *Optional[T] {
+		return l.Get().Filter(predicate)
+	})
+}
+
+// MapLazy returns a new Lazy that, once evaluated, holds the result of applying the given mapper
+// to the value of input, as Map would.
+func MapLazy[X, Y any](input *Lazy[X], mapper func(X) Y) *Lazy[Y] {
+	return LazyFrom(func() *Optional[Y] {
+		return Map(input.Get(), mapper)
+	})
+}
+
+// FlatMapLazy returns a new Lazy that, once evaluated, holds the result of applying the given mapper
+// to the value of input, as FlatMap would.
+func FlatMapLazy[X, Y any](input *Lazy[X], mapper func(X) *Optional[Y]) *Lazy[Y] {
+	return LazyFrom(func() *Optional[Y] {
+		return FlatMap(input.Get(), mapper)
+	})
+}
+
+// IsPresent evaluates this instance and returns true if it holds a value, and false otherwise.
+func (l *Lazy[T]) IsPresent() bool {
+	return l.Get().IsPresent()
+}
+
+// IsEmpty evaluates this instance and returns true if it holds no value, and false otherwise.
+func (l *Lazy[T]) IsEmpty() bool {
+	return !l.IsPresent()
+}
+
+// Unwrap evaluates this instance and returns its value, if any.
+// It panics with ErrNoValue otherwise.
+func (l *Lazy[T]) Unwrap() T {
+	return l.Get().Unwrap()
+}
+
+// IfPresent evaluates this instance and applies the given action to its value, if any.
+func (l *Lazy[T]) IfPresent(action func(T)) {
+	l.Get().IfPresent(action)
+}
+
+// IfPresentOrElse evaluates this instance and applies the given action to its value, if any,
+// or runs the given emptyAction otherwise.
+func (l *Lazy[T]) IfPresentOrElse(action func(T), emptyAction func()) {
+	l.Get().IfPresentOrElse(action, emptyAction)
+}
+
+// OrDefault evaluates this instance and returns its value, if any, or the zero value of T otherwise.
+func (l *Lazy[T]) OrDefault() T {
+	return l.Get().OrDefault()
+}
+
+// OrElse evaluates this instance and returns its value, if any, or the given fallback otherwise.
+func (l *Lazy[T]) OrElse(fallback T) T {
+	return l.Get().OrElse(fallback)
+}
+
+// OrElseGet evaluates this instance and returns its value, if any, or the result of the given supplier otherwise.
+func (l *Lazy[T]) OrElseGet(supplier func() T) T {
+	return l.Get().OrElseGet(supplier)
+}
+
+// Val evaluates this instance and returns its value and a nil error, if any.
+// It returns the zero value of T and ErrNoValue otherwise.
+func (l *Lazy[T]) Val() (T, error) {
+	return l.Get().Val()
+}
+
+// String returns the string representation of this instance without evaluating it.
+func (l *Lazy[T]) String() string {
+	if !l.IsEvaluated() {
+		return "Lazy.pending"
+	}
+
+	if l != nil && l.panicked {
+		return "Lazy.panicked"
+	}
+
+	o := l.Get()
+	if o.IsEmpty() {
+		return "Lazy.empty"
+	}
+
+	return spew.Sprintf("Lazy[%#+v]", o.Unwrap())
+}
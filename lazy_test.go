@@ -0,0 +1,169 @@
+package goptional
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLazyOf_DefersEvaluation(t *testing.T) {
+	calls := 0
+	l := LazyOf(func() int {
+		calls++
+		return 123
+	})
+
+	mapped := MapLazy(l.Filter(func(v int) bool { return v > 100 }), strconv.Itoa)
+	require.EqualValues(t, calls, 0)
+	require.False(t, mapped.IsEvaluated())
+	require.Equal(t, "Lazy.pending", mapped.String())
+
+	require.Equal(t, "123", mapped.OrElse(""))
+	require.EqualValues(t, calls, 1)
+	require.True(t, mapped.IsEvaluated())
+	require.True(t, l.IsEvaluated())
+	require.Equal(t, "Lazy[(string)123]", mapped.String())
+
+	require.Equal(t, "123", mapped.Unwrap())
+	require.EqualValues(t, calls, 1)
+}
+
+func TestLazy_MemoizesEachStep(t *testing.T) {
+	mapperCalls := 0
+	l := MapLazy(LazyOf(func() int { return 1 }), func(v int) int {
+		mapperCalls++
+		return v + 1
+	})
+
+	a := MapLazy(l, func(v int) int { return v * 10 })
+	b := MapLazy(l, func(v int) int { return v * 100 })
+
+	require.EqualValues(t, a.Unwrap(), 20)
+	require.EqualValues(t, b.Unwrap(), 200)
+	require.EqualValues(t, mapperCalls, 1)
+}
+
+func TestLazy_Empty(t *testing.T) {
+	l := LazyOf(func() *int { return nil })
+	mapperCalls := 0
+	mapped := MapLazy(l, func(v *int) int {
+		mapperCalls++
+		return *v
+	})
+
+	require.True(t, mapped.IsEmpty())
+	require.EqualValues(t, mapped.OrElse(-1), -1)
+	require.EqualValues(t, mapped.OrDefault(), 0)
+	require.EqualValues(t, mapped.OrElseGet(func() int { return -2 }), -2)
+	require.EqualValues(t, mapperCalls, 0)
+	require.Equal(t, "Lazy.empty", mapped.String())
+
+	_, err := mapped.Val()
+	require.ErrorIs(t, err, ErrNoValue)
+
+	require.Panics(t, func() {
+		mapped.Unwrap()
+	})
+}
+
+func TestLazy_Filter(t *testing.T) {
+	l := LazyFrom(func() *Optional[int] { return Of(3) })
+	require.True(t, l.Filter(func(v int) bool { return v%2 == 0 }).IsEmpty())
+	require.True(t, l.Filter(func(v int) bool { return v%2 != 0 }).IsPresent())
+}
+
+func TestFlatMapLazy(t *testing.T) {
+	parse := func(s string) *Optional[int] {
+		return FromErr(strconv.Atoi(s))
+	}
+
+	require.EqualValues(t, FlatMapLazy(LazyOf(func() string { return "12" }), parse).Unwrap(), 12)
+	require.True(t, FlatMapLazy(LazyOf(func() string { return "gm" }), parse).IsEmpty())
+}
+
+func TestLazy_IfPresent(t *testing.T) {
+	l := LazyOf(func() int { return 1 })
+
+	got := 0
+	l.IfPresent(func(v int) { got = v })
+	require.EqualValues(t, got, 1)
+
+	emptyCalled := false
+	l.Filter(func(int) bool { return false }).IfPresentOrElse(func(int) {}, func() { emptyCalled = true })
+	require.True(t, emptyCalled)
+}
+
+func TestLazy_Nil(t *testing.T) {
+	var l *Lazy[int]
+	require.True(t, l.IsEmpty())
+	require.True(t, l.IsEvaluated())
+	require.True(t, MapLazy(l, strconv.Itoa).IsEmpty())
+	require.Equal(t, "Lazy.empty", l.String())
+
+	require.True(t, LazyFrom[int](nil).IsEmpty())
+
+	nilSupplier := LazyOf[int](nil)
+	require.NotPanics(t, func() { nilSupplier.Get() })
+	require.True(t, nilSupplier.IsEmpty())
+	require.Equal(t, "Lazy.empty", nilSupplier.String())
+	require.True(t, MapLazy(nilSupplier, strconv.Itoa).IsEmpty())
+}
+
+func TestLazy_Snapshots(t *testing.T) {
+	l := LazyOf(func() int { return 1 })
+	l.Get().Take()
+	require.EqualValues(t, l.Unwrap(), 1)
+}
+
+func TestLazy_Concurrent(t *testing.T) {
+	const goroutines = 64
+
+	var (
+		mu    sync.Mutex
+		calls int
+		wg    sync.WaitGroup
+	)
+	l := MapLazy(LazyOf(func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return 1
+	}), func(v int) int { return v + 1 })
+
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func() {
+			defer wg.Done()
+			if l.Unwrap() != 2 {
+				panic("unexpected value")
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.EqualValues(t, calls, 1)
+}
+
+func TestLazy_Panic(t *testing.T) {
+	calls := 0
+	l := LazyOf(func() int {
+		calls++
+		panic("supplier")
+	})
+	mapped := MapLazy(l, strconv.Itoa)
+
+	for i := 0; i < 3; i++ {
+		require.PanicsWithValue(t, "supplier", func() {
+			mapped.OrElse("")
+		})
+		require.PanicsWithValue(t, "supplier", func() {
+			l.Get()
+		})
+	}
+	require.EqualValues(t, calls, 1)
+	require.True(t, l.IsEvaluated())
+	require.True(t, mapped.IsEvaluated())
+	require.Equal(t, "Lazy.panicked", mapped.String())
+}
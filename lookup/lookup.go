@@ -0,0 +1,137 @@
+// Package lookup provides map & slice helpers returning goptional.Optional,
+// so that lookups chain into Map, Filter, OrElse, etc.
+//
+// It lives in its own package to keep its short names (Get, Head, Last, ...) from clashing with goptional's.
+package lookup
+
+import "github.com/oleg-nykolyn/goptional"
+
+// Get returns an Optional holding the value stored in m under the given key, if any, or an empty Optional otherwise.
+// Like goptional.Of, it returns an empty Optional if the stored value is nil.
+func Get[M ~map[K]V, K comparable, V any](m M, key K) *goptional.Optional[V] {
+	return goptional.FromMap(m, key)
+}
+
+// GetOrInsertWith returns an Optional holding the value stored in m under the given key, if any.
+// Otherwise, it stores the value provided by the given supplier under key and returns an Optional holding it.
+//
+// If key is missing and either m or supplier is nil, nothing is stored and it returns an empty Optional.
+func GetOrInsertWith[M ~map[K]V, K comparable, V any](m M, key K, supplier func() V) *goptional.Optional[V] {
+	if v, ok := m[key]; ok {
+		return goptional.Of(v)
+	}
+
+	if m == nil || supplier == nil {
+		return goptional.Empty[V]()
+	}
+
+	v := supplier()
+	m[key] = v
+
+	return goptional.Of(v)
+}
+
+// FirstKeyWhere returns an Optional holding the first key of m, in iteration order, for which the given predicate holds,
+// or an empty Optional if there is none.
+// Map iteration order is unspecified: if more than one key matches, any of them may be returned.
+//
+// If predicate is nil, it returns an empty Optional.
+func FirstKeyWhere[M ~map[K]V, K comparable, V any](m M, predicate func(K, V) bool) *goptional.Optional[K] {
+	if predicate == nil {
+		return goptional.Empty[K]()
+	}
+
+	for k, v := range m {
+		if predicate(k, v) {
+			return goptional.Of(k)
+		}
+	}
+
+	return goptional.Empty[K]()
+}
+
+// MinBy returns an Optional holding the first smallest element of s according to the given less function,
+// or an empty Optional if s has no elements.
+//
+// If less is nil, it returns an empty Optional.
+func MinBy[S ~[]T, T any](s S, less func(a, b T) bool) *goptional.Optional[T] {
+	if len(s) == 0 || less == nil {
+		return goptional.Empty[T]()
+	}
+
+	min := s[0]
+	for _, v := range s[1:] {
+		if less(v, min) {
+			min = v
+		}
+	}
+
+	return goptional.Of(min)
+}
+
+// MaxBy returns an Optional holding the first largest element of s according to the given less function,
+// or an empty Optional if s has no elements.
+//
+// If less is nil, it returns an empty Optional.
+func MaxBy[S ~[]T, T any](s S, less func(a, b T) bool) *goptional.Optional[T] {
+	if len(s) == 0 || less == nil {
+		return goptional.Empty[T]()
+	}
+
+	max := s[0]
+	for _, v := range s[1:] {
+		if less(max, v) {
+			max = v
+		}
+	}
+
+	return goptional.Of(max)
+}
+
+// MinEntryBy returns an Optional holding a smallest entry of m according to the given less function,
+// or an empty Optional if m has no entries.
+// Map iteration order is unspecified: if more than one entry is smallest, any of them may be returned.
+//
+// If less is nil, it returns an empty Optional.
+func MinEntryBy[M ~map[K]V, K comparable, V any](m M, less func(a, b *goptional.Pair[K, V]) bool) *goptional.Optional[*goptional.Pair[K, V]] {
+	return MinBy(entries(m), less)
+}
+
+// MaxEntryBy returns an Optional holding a largest entry of m according to the given less function,
+// or an empty Optional if m has no entries.
+// Map iteration order is unspecified: if more than one entry is largest, any of them may be returned.
+//
+// If less is nil, it returns an empty Optional.
+func MaxEntryBy[M ~map[K]V, K comparable, V any](m M, less func(a, b *goptional.Pair[K, V]) bool) *goptional.Optional[*goptional.Pair[K, V]] {
+	return MaxBy(entries(m), less)
+}
+
+// Head returns an Optional holding the first element of s, or an empty Optional if s has no elements.
+func Head[S ~[]T, T any](s S) *goptional.Optional[T] {
+	return At(s, 0)
+}
+
+// Last returns an Optional holding the last element of s, or an empty Optional if s has no elements.
+func Last[S ~[]T, T any](s S) *goptional.Optional[T] {
+	return At(s, len(s)-1)
+}
+
+// At returns an Optional holding the element of s at the given index,
+// or an empty Optional if index is out of bounds.
+func At[S ~[]T, T any](s S, index int) *goptional.Optional[T] {
+	if index < 0 || index >= len(s) {
+		return goptional.Empty[T]()
+	}
+
+	return goptional.Of(s[index])
+}
+
+// entries returns the entries of m as Pairs of key & value.
+func entries[M ~map[K]V, K comparable, V any](m M) []*goptional.Pair[K, V] {
+	pairs := make([]*goptional.Pair[K, V], 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, &goptional.Pair[K, V]{First: k, Second: v})
+	}
+
+	return pairs
+}
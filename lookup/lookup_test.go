@@ -0,0 +1,104 @@
+package lookup
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/oleg-nykolyn/goptional"
+	"github.com/stretchr/testify/require"
+)
+
+type sampleAges map[string]int
+
+func TestGet(t *testing.T) {
+	ages := sampleAges{"alice": 30, "bob": 0}
+	require.EqualValues(t, Get(ages, "alice").Unwrap(), 30)
+	require.True(t, Get(ages, "bob").IsPresent())
+	require.True(t, Get(ages, "carol").IsEmpty())
+	require.True(t, Get[sampleAges](nil, "alice").IsEmpty())
+	require.True(t, Get(map[string]*int{"alice": nil}, "alice").IsEmpty())
+}
+
+func TestGet_Chains(t *testing.T) {
+	ages := sampleAges{"alice": 30}
+	adult := Get(ages, "alice").Filter(func(age int) bool { return age >= 18 })
+	require.True(t, adult.IsPresent())
+	require.EqualValues(t, Get(ages, "carol").OrElse(-1), -1)
+}
+
+func TestGetOrInsertWith(t *testing.T) {
+	calls := 0
+	supplier := func() int {
+		calls++
+		return 42
+	}
+
+	ages := sampleAges{"alice": 30}
+	require.EqualValues(t, GetOrInsertWith(ages, "alice", supplier).Unwrap(), 30)
+	require.EqualValues(t, calls, 0)
+
+	require.EqualValues(t, GetOrInsertWith(ages, "bob", supplier).Unwrap(), 42)
+	require.EqualValues(t, calls, 1)
+	require.EqualValues(t, ages["bob"], 42)
+
+	require.EqualValues(t, GetOrInsertWith(ages, "bob", supplier).Unwrap(), 42)
+	require.EqualValues(t, calls, 1)
+}
+
+func TestGetOrInsertWith_Nil(t *testing.T) {
+	ages := sampleAges{}
+	require.True(t, GetOrInsertWith(ages, "alice", nil).IsEmpty())
+	require.NotContains(t, ages, "alice")
+
+	require.True(t, GetOrInsertWith[sampleAges](nil, "alice", func() int { return 1 }).IsEmpty())
+}
+
+func TestFirstKeyWhere(t *testing.T) {
+	ages := sampleAges{"alice": 30, "bob": 10}
+	require.EqualValues(t, FirstKeyWhere(ages, func(_ string, age int) bool { return age < 18 }).Unwrap(), "bob")
+	require.True(t, FirstKeyWhere(ages, func(_ string, age int) bool { return age > 100 }).IsEmpty())
+	require.True(t, FirstKeyWhere(ages, nil).IsEmpty())
+	require.True(t, FirstKeyWhere(sampleAges{}, func(string, int) bool { return true }).IsEmpty())
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	byLen := func(a, b string) bool { return len(a) < len(b) }
+	words := []string{"ccc", "a", "bb", "b", "ddd"}
+
+	require.EqualValues(t, MinBy(words, byLen).Unwrap(), "a")
+	require.EqualValues(t, MaxBy(words, byLen).Unwrap(), "ccc")
+
+	require.True(t, MinBy([]string{}, byLen).IsEmpty())
+	require.True(t, MaxBy[[]string](nil, byLen).IsEmpty())
+	require.True(t, MinBy(words, nil).IsEmpty())
+	require.True(t, MaxBy(words, nil).IsEmpty())
+}
+
+func TestMinEntryByMaxEntryBy(t *testing.T) {
+	byAge := func(a, b *goptional.Pair[string, int]) bool { return a.Second < b.Second }
+	ages := sampleAges{"alice": 30, "bob": 10, "carol": 50}
+
+	require.EqualValues(t, MinEntryBy(ages, byAge).Unwrap(), &goptional.Pair[string, int]{First: "bob", Second: 10})
+	require.EqualValues(t, MaxEntryBy(ages, byAge).Unwrap(), &goptional.Pair[string, int]{First: "carol", Second: 50})
+
+	require.True(t, MinEntryBy(sampleAges{}, byAge).IsEmpty())
+	require.True(t, MaxEntryBy(ages, nil).IsEmpty())
+}
+
+func TestHeadLastAt(t *testing.T) {
+	s := []string{"a", "b", "c"}
+	require.EqualValues(t, Head(s).Unwrap(), "a")
+	require.EqualValues(t, Last(s).Unwrap(), "c")
+	require.EqualValues(t, At(s, 1).Unwrap(), "b")
+
+	require.True(t, At(s, -1).IsEmpty())
+	require.True(t, At(s, 3).IsEmpty())
+	require.True(t, Head([]string{}).IsEmpty())
+	require.True(t, Last[[]string](nil).IsEmpty())
+}
+
+func TestAt_Chains(t *testing.T) {
+	args := []string{"cmd", "GM"}
+	require.EqualValues(t, goptional.Map(At(args, 1), strings.ToLower).OrElse("-"), "gm")
+	require.EqualValues(t, goptional.Map(At(args, 2), strings.ToLower).OrElse("-"), "-")
+}
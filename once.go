@@ -0,0 +1,98 @@
+package goptional
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// OnceOptional is a lazily-initialized cell: it is empty until first use,
+// then holds the outcome of a supplier that runs exactly once across goroutines.
+// Its zero value is ready to use with GetOrInit; it must not be copied after first use.
+//
+// The outcome is fixed for good: if the supplier returns an error, every caller gets that error,
+// and if it panics, every caller panics with the same value.
+type OnceOptional[T any] struct {
+	once      sync.Once
+	done      atomic.Bool
+	supplier  func() (T, error)
+	value     *Optional[T]
+	err       error
+	panicked  bool
+	recovered interface{}
+}
+
+// OnceOf returns a new OnceOptional that is initialized by the given supplier on first call to Get.
+func OnceOf[T any](supplier func() (T, error)) *OnceOptional[T] {
+	return &OnceOptional[T]{supplier: supplier}
+}
+
+// Get initializes this instance with the supplier given to OnceOf, if not done yet,
+// and returns its outcome.
+// The value is held as Of would, so a nil value results in an empty Optional.
+// It returns an empty Optional and an ErrMutationOnNil error if this instance is nil.
+func (o *OnceOptional[T]) Get() (*Optional[T], error) {
+	if o == nil {
+		return Empty[T](), ErrMutationOnNil
+	}
+
+	return o.GetOrInit(o.supplier)
+}
+
+// GetOrInit initializes this instance with the given supplier, if not done yet, and returns its outcome.
+// The supplier is ignored if this instance has already been initialized, or is being initialized by another goroutine,
+// in which case GetOrInit blocks until that initialization completes.
+// A nil supplier initializes this instance to empty.
+// It returns an empty Optional and an ErrMutationOnNil error if this instance is nil.
+func (o *OnceOptional[T]) GetOrInit(supplier func() (T, error)) (*Optional[T], error) {
+	if o == nil {
+		return Empty[T](), ErrMutationOnNil
+	}
+
+	o.once.Do(func() {
+		o.init(supplier)
+	})
+
+	if o.panicked {
+		panic(o.recovered)
+	}
+
+	return snapshot(o.value), o.err
+}
+
+// Peek returns the value held by this instance, if initialized successfully, without initializing it.
+// It returns an empty Optional otherwise.
+func (o *OnceOptional[T]) Peek() *Optional[T] {
+	if !o.IsInitialized() || o.panicked {
+		return Empty[T]()
+	}
+
+	return snapshot(o.value)
+}
+
+// IsInitialized returns true if the supplier of this instance has completed, whatever its outcome.
+func (o *OnceOptional[T]) IsInitialized() bool {
+	return o != nil && o.done.Load()
+}
+
+func (o *OnceOptional[T]) init(supplier func() (T, error)) {
+	defer o.done.Store(true)
+
+	defer func() {
+		if r := recover(); r != nil {
+			o.panicked = true
+			o.recovered = r
+		}
+	}()
+
+	if supplier == nil {
+		return
+	}
+
+	value, err := supplier()
+	if err != nil {
+		o.err = err
+		return
+	}
+
+	o.value = Of(value)
+}
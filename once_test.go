@@ -0,0 +1,146 @@
+package goptional
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnceOf_Get(t *testing.T) {
+	calls := 0
+	o := OnceOf(func() (int, error) {
+		calls++
+		return 123, nil
+	})
+	require.False(t, o.IsInitialized())
+	require.True(t, o.Peek().IsEmpty())
+
+	for i := 0; i < 3; i++ {
+		opt, err := o.Get()
+		require.NoError(t, err)
+		require.EqualValues(t, opt.Unwrap(), 123)
+	}
+	require.EqualValues(t, calls, 1)
+	require.True(t, o.IsInitialized())
+	require.EqualValues(t, o.Peek().Unwrap(), 123)
+}
+
+func TestOnceOptional_GetOrInit(t *testing.T) {
+	var o OnceOptional[string]
+
+	opt, err := o.GetOrInit(func() (string, error) { return "gm", nil })
+	require.NoError(t, err)
+	require.EqualValues(t, opt.Unwrap(), "gm")
+
+	opt, err = o.GetOrInit(func() (string, error) { return "gn", nil })
+	require.NoError(t, err)
+	require.EqualValues(t, opt.Unwrap(), "gm")
+}
+
+func TestOnceOptional_NilValue(t *testing.T) {
+	o := OnceOf(func() (*int, error) { return nil, nil })
+	opt, err := o.Get()
+	require.NoError(t, err)
+	require.True(t, opt.IsEmpty())
+	require.True(t, o.IsInitialized())
+}
+
+func TestOnceOptional_NilSupplier(t *testing.T) {
+	var o OnceOptional[int]
+	opt, err := o.Get()
+	require.NoError(t, err)
+	require.True(t, opt.IsEmpty())
+	require.True(t, o.IsInitialized())
+}
+
+func TestOnceOptional_Nil(t *testing.T) {
+	var o *OnceOptional[int]
+
+	opt, err := o.Get()
+	require.ErrorIs(t, err, ErrMutationOnNil)
+	require.NotNil(t, opt)
+	require.True(t, opt.IsEmpty())
+
+	opt, err = o.GetOrInit(func() (int, error) { return 1, nil })
+	require.ErrorIs(t, err, ErrMutationOnNil)
+	require.NotNil(t, opt)
+	require.True(t, opt.IsEmpty())
+
+	require.False(t, o.IsInitialized())
+	require.True(t, o.Peek().IsEmpty())
+}
+
+func TestOnceOptional_Error(t *testing.T) {
+	errSupplier := errors.New("supplier")
+	calls := 0
+	o := OnceOf(func() (int, error) {
+		calls++
+		return 1, errSupplier
+	})
+
+	for i := 0; i < 3; i++ {
+		opt, err := o.Get()
+		require.ErrorIs(t, err, errSupplier)
+		require.True(t, opt.IsEmpty())
+	}
+	require.EqualValues(t, calls, 1)
+	require.True(t, o.Peek().IsEmpty())
+}
+
+func TestOnceOptional_Panic(t *testing.T) {
+	calls := 0
+	o := OnceOf(func() (int, error) {
+		calls++
+		panic("supplier")
+	})
+
+	for i := 0; i < 3; i++ {
+		require.PanicsWithValue(t, "supplier", func() {
+			_, _ = o.Get()
+		})
+	}
+	require.EqualValues(t, calls, 1)
+	require.True(t, o.IsInitialized())
+	require.True(t, o.Peek().IsEmpty())
+}
+
+func TestOnceOptional_Snapshots(t *testing.T) {
+	o := OnceOf(func() (int, error) { return 1, nil })
+
+	opt, err := o.Get()
+	require.NoError(t, err)
+	opt.Take()
+
+	opt, err = o.Get()
+	require.NoError(t, err)
+	require.EqualValues(t, opt.Unwrap(), 1)
+}
+
+func TestOnceOptional_Concurrent(t *testing.T) {
+	const goroutines = 64
+
+	var (
+		calls int64
+		wg    sync.WaitGroup
+	)
+	o := OnceOf(func() (int, error) {
+		return int(atomic.AddInt64(&calls, 1)), nil
+	})
+
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func() {
+			defer wg.Done()
+			opt, err := o.Get()
+			if err != nil || opt.Unwrap() != 1 {
+				panic("unexpected outcome")
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.EqualValues(t, calls, 1)
+}
@@ -0,0 +1,384 @@
+package goptional
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// Option is the value-semantics counterpart of Optional.
+// At any time it can either hold a value or be empty.
+//
+// Unlike *Optional[T], an Option[T] is a plain struct that is passed and returned by value,
+// so that chains of OptionOf / Filter / MapOption calls need not allocate on the heap.
+// The zero value of Option is empty.
+type Option[T any] struct {
+	value        T
+	isValueValid bool
+}
+
+// EmptyOption returns a new empty Option.
+func EmptyOption[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// OptionOf attempts to return a new non-empty Option wrapping the given value.
+// If such value is either invalid or nil, it returns an empty Option instead.
+func OptionOf[T any](value T) Option[T] {
+	if isValueValid(value) {
+		return Option[T]{value: value, isValueValid: true}
+	}
+
+	return Option[T]{}
+}
+
+// Option returns an Option holding the value of this instance, if any, or an empty Option otherwise.
+func (o *Optional[T]) Option() Option[T] {
+	if o.IsEmpty() {
+		return Option[T]{}
+	}
+
+	return Option[T]{value: o.value, isValueValid: true}
+}
+
+// Optional returns a new Optional holding the value of this instance, if any, or a new empty Optional otherwise.
+func (o Option[T]) Optional() *Optional[T] {
+	return &Optional[T]{value: o.value, isValueValid: o.isValueValid}
+}
+
+// IsPresent returns true if this instance holds a value, and false otherwise.
+func (o Option[T]) IsPresent() bool {
+	return o.isValueValid
+}
+
+// IsEmpty returns true if this instance does not hold a value, and false otherwise.
+func (o Option[T]) IsEmpty() bool {
+	return !o.isValueValid
+}
+
+// Unwrap returns the value held by this instance, if any, or _panics_ otherwise.
+//
+// Use it only if you _know_ what you are doing.
+// Usage of OrDefault / OrElse is preferred.
+func (o Option[T]) Unwrap() T {
+	if !o.isValueValid {
+		panic(ErrNoValue)
+	}
+
+	return o.value
+}
+
+// IfPresent applies the action to the value held by this instance.
+// Does nothing if this instance is empty. If action is nil, nothing is done.
+func (o Option[T]) IfPresent(action func(T)) {
+	if o.isValueValid && action != nil {
+		action(o.value)
+	}
+}
+
+// IfPresentOrElse applies the action to the value held by this instance or calls emptyAction if this instance is empty.
+// If action or emptyAction are nil, nothing is done.
+func (o Option[T]) IfPresentOrElse(action func(T), emptyAction func()) {
+	if o.isValueValid {
+		if action != nil {
+			action(o.value)
+		}
+	} else {
+		if emptyAction != nil {
+			emptyAction()
+		}
+	}
+}
+
+// Filter returns this instance if it is empty or
+// if the predicate applied to its value holds.
+// If this instance is not empty and predicate is nil, it returns an empty Option.
+func (o Option[T]) Filter(predicate func(T) bool) Option[T] {
+	if !o.isValueValid {
+		return o
+	}
+
+	if predicate == nil || !predicate(o.value) {
+		return Option[T]{}
+	}
+
+	return o
+}
+
+// Match returns the result of the application of onPresent to the value held by this instance, if any,
+// or the result of onEmpty otherwise.
+// Use FoldOption to compute a result of a type other than T.
+//
+// It returns the zero value of T if the function matching the state of this instance is nil.
+func (o Option[T]) Match(onPresent func(T) T, onEmpty func() T) T {
+	return FoldOption(o, onPresent, onEmpty)
+}
+
+// And returns one of the following:
+//   - this instance if it is empty
+//   - a new Option provided by the given supplier
+//
+// If this instance is not empty and supplier is nil, it returns an empty Option.
+func (o Option[T]) And(supplier func() Option[T]) Option[T] {
+	if !o.isValueValid {
+		return o
+	}
+
+	if supplier == nil {
+		return Option[T]{}
+	}
+
+	return supplier()
+}
+
+// Or returns one of the following:
+//   - this instance if it holds a value
+//   - a new Option provided by the given supplier
+//
+// It returns this instance if it is empty and supplier is nil.
+func (o Option[T]) Or(supplier func() Option[T]) Option[T] {
+	if o.isValueValid || supplier == nil {
+		return o
+	}
+
+	return supplier()
+}
+
+// Xor returns one of the following:
+//   - an empty Option if both are either non-empty or empty
+//   - the first non-empty Option between this instance & o2
+func (o Option[T]) Xor(o2 Option[T]) Option[T] {
+	if o.isValueValid == o2.isValueValid {
+		return Option[T]{}
+	}
+
+	if o.isValueValid {
+		return o
+	}
+
+	return o2
+}
+
+// OrDefault returns the value held by this instance, if any, or the zero value of T otherwise.
+func (o Option[T]) OrDefault() T {
+	return o.value
+}
+
+// OrElse returns the value held by this instance, if any, or the given fallback value otherwise.
+func (o Option[T]) OrElse(fallback T) T {
+	if !o.isValueValid {
+		return fallback
+	}
+
+	return o.value
+}
+
+// OrElseGet returns the value held by this instance, if any, or a value provided by the given supplier otherwise.
+//
+// If this instance is empty and supplier is nil, it returns the zero value of T.
+func (o Option[T]) OrElseGet(supplier func() T) T {
+	if o.isValueValid {
+		return o.value
+	}
+
+	if supplier == nil {
+		return getZeroOfType[T]()
+	}
+
+	return supplier()
+}
+
+// UnwrapOr returns the value held by this instance, if any, or panics with an error provided by the given supplier otherwise.
+//
+// If this instance is empty and supplier is either nil or returns a nil error, it panics with ErrNoValue instead.
+func (o Option[T]) UnwrapOr(supplier func() error) T {
+	if !o.isValueValid {
+		if supplier != nil {
+			if err := supplier(); err != nil {
+				panic(err)
+			}
+		}
+		panic(ErrNoValue)
+	}
+
+	return o.value
+}
+
+// Is checks whether the value of this instance satisfies the given predicate.
+// If this instance is empty, it returns false.
+//
+// If this instance is not empty and predicate is nil, it returns false.
+func (o Option[T]) Is(predicate func(T) bool) bool {
+	if !o.isValueValid || predicate == nil {
+		return false
+	}
+
+	return predicate(o.value)
+}
+
+// Val returns the value held by this instance, if any. It returns ErrNoValue otherwise.
+func (o Option[T]) Val() (T, error) {
+	return o.ValOr(ErrNoValue)
+}
+
+// ValOr returns the value held by this instance, if any. It returns the given error otherwise.
+// On the other hand, if this instance is empty and err is nil, it returns ErrNoValue.
+func (o Option[T]) ValOr(err error) (T, error) {
+	if o.isValueValid {
+		return o.value, nil
+	}
+
+	if err == nil {
+		err = ErrNoValue
+	}
+
+	return getZeroOfType[T](), err
+}
+
+// ValOrElse returns the value held by this instance, if any.
+// It returns the error provided by the given supplier otherwise.
+//
+// If this instance is empty and supplier is either nil or returns a nil err, it returns ErrNoValue.
+func (o Option[T]) ValOrElse(supplier func() error) (T, error) {
+	if o.isValueValid || supplier == nil {
+		return o.ValOr(ErrNoValue)
+	}
+
+	return o.ValOr(supplier())
+}
+
+// Equals compares two Options for deep equality.
+// It returns true if both Options contain the same value, or if both Options are empty.
+// It returns false otherwise.
+func (o Option[T]) Equals(o2 Option[T]) bool {
+	return o.EqualsBy(o2, nil)
+}
+
+// EqualsBy compares two Options for equality through a custom predicate.
+// It returns true if both Options are not empty and the predicate applied to their values holds,
+// or if both Options are empty.
+// It returns false otherwise.
+//
+// Note that a nil predicate is replaced by reflect.DeepEqual
+func (o Option[T]) EqualsBy(o2 Option[T], predicate func(v1, v2 T) bool) bool {
+	if !o.isValueValid || !o2.isValueValid {
+		return o.isValueValid == o2.isValueValid
+	}
+
+	if predicate != nil {
+		return predicate(o.value, o2.value)
+	}
+
+	return reflect.DeepEqual(o.value, o2.value)
+}
+
+// Take takes the value out of this instance, if any, leaving an empty Option in its place.
+// If this instance is nil, it returns an empty Option.
+func (o *Option[T]) Take() Option[T] {
+	if o == nil {
+		return Option[T]{}
+	}
+
+	v := *o
+	*o = Option[T]{}
+	return v
+}
+
+// Replace replaces the value in this instance with the given value,
+// returning the old value if present, leaving a non-empty Option in its place.
+// It returns an ErrMutationOnNil error if this instance is nil.
+func (o *Option[T]) Replace(value T) (Option[T], error) {
+	if o == nil {
+		return Option[T]{}, ErrMutationOnNil
+	}
+
+	v := *o
+	*o = OptionOf(value)
+	return v, nil
+}
+
+// MarshalJSON returns the JSON representation of this instance.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.isValueValid {
+		return nilAsJSON, nil
+	}
+
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON populates this instance with the given JSON data.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if o == nil {
+		return ErrMutationOnNil
+	}
+
+	if len(data) == 0 || bytes.Equal(data, nilAsJSON) {
+		*o = Option[T]{}
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = OptionOf(value)
+
+	return nil
+}
+
+// String returns the string representation of this instance.
+func (o Option[T]) String() string {
+	if !o.isValueValid {
+		return "Option.empty"
+	}
+
+	return spew.Sprintf("Option[%#+v]", o.value)
+}
+
+// MapOption returns one of the following:
+//   - an empty Option if input is empty
+//   - a new Option holding a value that results from the application of the given mapper to the value of input
+//
+// If input is not empty and mapper is nil, it returns an empty Option of the target type.
+func MapOption[X, Y any](input Option[X], mapper func(X) Y) Option[Y] {
+	if !input.isValueValid || mapper == nil {
+		return Option[Y]{}
+	}
+
+	return OptionOf(mapper(input.value))
+}
+
+// FlatMapOption returns one of the following:
+//   - an empty Option if input is empty
+//   - a new Option that results from the application of the given mapper to the value of input
+//
+// If input is not empty and mapper is nil, it returns an empty Option of the target type.
+func FlatMapOption[X, Y any](input Option[X], mapper func(X) Option[Y]) Option[Y] {
+	if !input.isValueValid || mapper == nil {
+		return Option[Y]{}
+	}
+
+	return mapper(input.value)
+}
+
+// FoldOption returns one of the following:
+//   - the result of the application of onPresent to the value of input if input is not empty
+//   - the result of onEmpty if input is empty
+//
+// It returns the zero value of R if the function matching the state of input is nil.
+func FoldOption[T, R any](input Option[T], onPresent func(T) R, onEmpty func() R) R {
+	if !input.isValueValid {
+		if onEmpty == nil {
+			return getZeroOfType[R]()
+		}
+		return onEmpty()
+	}
+
+	if onPresent == nil {
+		return getZeroOfType[R]()
+	}
+
+	return onPresent(input.value)
+}
@@ -0,0 +1,258 @@
+package goptional
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionOf(t *testing.T) {
+	require.True(t, OptionOf(123).IsPresent())
+	require.True(t, OptionOf("").IsPresent())
+	require.True(t, OptionOf[*int](nil).IsEmpty())
+	require.True(t, OptionOf[interface{}](nil).IsEmpty())
+	require.True(t, EmptyOption[int]().IsEmpty())
+
+	var zero Option[int]
+	require.True(t, zero.IsEmpty())
+}
+
+func TestOption_Conversions(t *testing.T) {
+	require.EqualValues(t, Of(123).Option().Unwrap(), 123)
+	require.True(t, Empty[int]().Option().IsEmpty())
+
+	var opt *Optional[int]
+	require.True(t, opt.Option().IsEmpty())
+
+	require.True(t, OptionOf(123).Optional().Equals(Of(123)))
+	require.True(t, EmptyOption[int]().Optional().IsEmpty())
+}
+
+func TestOption_Unwrap(t *testing.T) {
+	require.EqualValues(t, OptionOf(123).Unwrap(), 123)
+
+	defer func() {
+		r := recover()
+		require.NotNil(t, r)
+		require.ErrorIs(t, r.(error), ErrNoValue)
+	}()
+	_ = EmptyOption[int]().Unwrap()
+}
+
+func TestOption_IfPresent(t *testing.T) {
+	v := 0
+	OptionOf(123).IfPresent(func(x int) { v = x })
+	require.EqualValues(t, v, 123)
+
+	EmptyOption[int]().IfPresent(func(x int) { v = -1 })
+	require.EqualValues(t, v, 123)
+
+	OptionOf(123).IfPresent(nil)
+}
+
+func TestOption_IfPresentOrElse(t *testing.T) {
+	var actionCalled, emptyActionCalled bool
+	EmptyOption[int]().IfPresentOrElse(func(_ int) { actionCalled = true }, func() { emptyActionCalled = true })
+	require.False(t, actionCalled)
+	require.True(t, emptyActionCalled)
+
+	emptyActionCalled = false
+	OptionOf(123).IfPresentOrElse(func(_ int) { actionCalled = true }, func() { emptyActionCalled = true })
+	require.True(t, actionCalled)
+	require.False(t, emptyActionCalled)
+
+	OptionOf(123).IfPresentOrElse(nil, nil)
+	EmptyOption[int]().IfPresentOrElse(nil, nil)
+}
+
+func TestOption_Filter(t *testing.T) {
+	odd := func(x int) bool { return x%2 != 0 }
+	require.True(t, OptionOf(123).Filter(odd).IsPresent())
+	require.True(t, OptionOf(124).Filter(odd).IsEmpty())
+	require.True(t, OptionOf(123).Filter(nil).IsEmpty())
+	require.True(t, EmptyOption[int]().Filter(odd).IsEmpty())
+}
+
+func TestOption_Match(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+	fallback := func() int { return -1 }
+
+	require.EqualValues(t, OptionOf(123).Match(double, fallback), 246)
+	require.EqualValues(t, EmptyOption[int]().Match(double, fallback), -1)
+	require.EqualValues(t, EmptyOption[int]().Match(double, nil), 0)
+}
+
+func TestOption_BooleanOperators(t *testing.T) {
+	some := func() Option[int] { return OptionOf(321) }
+
+	require.EqualValues(t, OptionOf(123).And(some).Unwrap(), 321)
+	require.True(t, EmptyOption[int]().And(some).IsEmpty())
+	require.True(t, OptionOf(123).And(nil).IsEmpty())
+
+	require.EqualValues(t, OptionOf(123).Or(some).Unwrap(), 123)
+	require.EqualValues(t, EmptyOption[int]().Or(some).Unwrap(), 321)
+	require.True(t, EmptyOption[int]().Or(nil).IsEmpty())
+
+	require.True(t, OptionOf(1).Xor(OptionOf(2)).IsEmpty())
+	require.True(t, EmptyOption[int]().Xor(EmptyOption[int]()).IsEmpty())
+	require.EqualValues(t, OptionOf(1).Xor(EmptyOption[int]()).Unwrap(), 1)
+	require.EqualValues(t, EmptyOption[int]().Xor(OptionOf(2)).Unwrap(), 2)
+}
+
+func TestOption_ValueRetrieval(t *testing.T) {
+	require.EqualValues(t, OptionOf(123).OrDefault(), 123)
+	require.EqualValues(t, EmptyOption[int]().OrDefault(), 0)
+	require.EqualValues(t, EmptyOption[int]().OrElse(321), 321)
+	require.EqualValues(t, EmptyOption[int]().OrElseGet(func() int { return 321 }), 321)
+	require.EqualValues(t, EmptyOption[int]().OrElseGet(nil), 0)
+
+	v, err := OptionOf(123).Val()
+	require.NoError(t, err)
+	require.EqualValues(t, v, 123)
+
+	_, err = EmptyOption[int]().Val()
+	require.ErrorIs(t, err, ErrNoValue)
+
+	_, err = EmptyOption[int]().ValOr(errSample)
+	require.ErrorIs(t, err, errSample)
+
+	_, err = EmptyOption[int]().ValOr(nil)
+	require.ErrorIs(t, err, ErrNoValue)
+
+	_, err = EmptyOption[int]().ValOrElse(func() error { return errSample })
+	require.ErrorIs(t, err, errSample)
+
+	_, err = EmptyOption[int]().ValOrElse(func() error { return nil })
+	require.ErrorIs(t, err, ErrNoValue)
+
+	_, err = EmptyOption[int]().ValOrElse(nil)
+	require.ErrorIs(t, err, ErrNoValue)
+}
+
+func TestOption_UnwrapOr(t *testing.T) {
+	require.EqualValues(t, OptionOf(123).UnwrapOr(nil), 123)
+
+	require.PanicsWithError(t, errSample.Error(), func() {
+		EmptyOption[int]().UnwrapOr(func() error { return errSample })
+	})
+	require.PanicsWithError(t, ErrNoValue.Error(), func() {
+		EmptyOption[int]().UnwrapOr(func() error { return nil })
+	})
+	require.PanicsWithError(t, ErrNoValue.Error(), func() {
+		EmptyOption[int]().UnwrapOr(nil)
+	})
+}
+
+func TestOption_Is(t *testing.T) {
+	require.True(t, OptionOf(123).Is(func(x int) bool { return x > 100 }))
+	require.False(t, OptionOf(123).Is(nil))
+	require.False(t, EmptyOption[int]().Is(func(int) bool { return true }))
+}
+
+func TestOption_Equals(t *testing.T) {
+	require.True(t, OptionOf([]int{1, 2}).Equals(OptionOf([]int{1, 2})))
+	require.False(t, OptionOf([]int{1, 2}).Equals(OptionOf([]int{1})))
+	require.False(t, OptionOf([]int{1, 2}).Equals(EmptyOption[[]int]()))
+	require.True(t, EmptyOption[[]int]().Equals(EmptyOption[[]int]()))
+
+	sameLen := func(v1, v2 []int) bool { return len(v1) == len(v2) }
+	require.True(t, OptionOf([]int{1, 2}).EqualsBy(OptionOf([]int{3, 4}), sameLen))
+}
+
+func TestOption_Mutations(t *testing.T) {
+	opt := OptionOf(123)
+	taken := opt.Take()
+	require.EqualValues(t, taken.Unwrap(), 123)
+	require.True(t, opt.IsEmpty())
+
+	old, err := opt.Replace(321)
+	require.NoError(t, err)
+	require.True(t, old.IsEmpty())
+	require.EqualValues(t, opt.Unwrap(), 321)
+
+	old, err = opt.Replace(456)
+	require.NoError(t, err)
+	require.EqualValues(t, old.Unwrap(), 321)
+	require.EqualValues(t, opt.Unwrap(), 456)
+
+	var optNil *Option[int]
+	require.True(t, optNil.Take().IsEmpty())
+	_, err = optNil.Replace(1)
+	require.ErrorIs(t, err, ErrMutationOnNil)
+}
+
+func TestOption_JSON(t *testing.T) {
+	type sample struct {
+		Name Option[string] `json:"name"`
+		Age  Option[int]    `json:"age"`
+	}
+
+	data, err := json.Marshal(sample{Name: OptionOf("gm")})
+	require.NoError(t, err)
+	require.JSONEq(t, string(data), `{"name":"gm","age":null}`)
+
+	s := sample{Age: OptionOf(123)}
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"gn","age":null}`), &s))
+	require.EqualValues(t, s.Name.Unwrap(), "gn")
+	require.True(t, s.Age.IsEmpty())
+
+	require.Error(t, json.Unmarshal([]byte(`{"age":"gm"}`), &s))
+
+	var optNil *Option[int]
+	require.ErrorIs(t, optNil.UnmarshalJSON([]byte("123")), ErrMutationOnNil)
+}
+
+func TestOption_String(t *testing.T) {
+	require.EqualValues(t, OptionOf(123).String(), "Option[(int)123]")
+	require.EqualValues(t, EmptyOption[int]().String(), "Option.empty")
+	require.EqualValues(t, fmt.Sprint(OptionOf(123)), "Option[(int)123]")
+}
+
+func TestMapOption(t *testing.T) {
+	require.EqualValues(t, MapOption(OptionOf(123), strconv.Itoa).Unwrap(), "123")
+	require.True(t, MapOption(EmptyOption[int](), strconv.Itoa).IsEmpty())
+	require.True(t, MapOption[int, string](OptionOf(123), nil).IsEmpty())
+	require.True(t, MapOption(OptionOf(123), func(int) []int { return nil }).IsEmpty())
+}
+
+func TestFlatMapOption(t *testing.T) {
+	atoi := func(s string) Option[int] {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return EmptyOption[int]()
+		}
+		return OptionOf(v)
+	}
+
+	require.EqualValues(t, FlatMapOption(OptionOf("123"), atoi).Unwrap(), 123)
+	require.True(t, FlatMapOption(OptionOf("gm"), atoi).IsEmpty())
+	require.True(t, FlatMapOption(EmptyOption[string](), atoi).IsEmpty())
+	require.True(t, FlatMapOption[string, int](OptionOf("123"), nil).IsEmpty())
+}
+
+func TestFoldOption(t *testing.T) {
+	require.EqualValues(t, FoldOption(OptionOf(123), strconv.Itoa, func() string { return "-" }), "123")
+	require.EqualValues(t, FoldOption(EmptyOption[int](), strconv.Itoa, func() string { return "-" }), "-")
+	require.EqualValues(t, FoldOption[int, string](OptionOf(123), nil, nil), "")
+}
+
+var benchSink int
+
+func BenchmarkOptional_Chain(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		opt := Map(Of(i).Filter(func(x int) bool { return x%2 == 0 }), func(x int) int { return x * 2 })
+		benchSink += opt.OrElse(-1)
+	}
+}
+
+func BenchmarkOption_Chain(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		opt := MapOption(OptionOf(i).Filter(func(x int) bool { return x%2 == 0 }), func(x int) int { return x * 2 })
+		benchSink += opt.OrElse(-1)
+	}
+}
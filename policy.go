@@ -0,0 +1,156 @@
+package goptional
+
+import (
+	"math"
+	"strings"
+)
+
+// Policy decides whether a value that is neither invalid nor nil should still be considered absent,
+// e.g. the empty string or NaN at API boundaries.
+// It returns true if the given value is to be kept.
+//
+// A Policy is meant to be defined once per field type and reused wherever such values are wrapped or decoded.
+type Policy[T any] func(T) bool
+
+// NonZero returns a Policy that treats the zero value of T as absent.
+func NonZero[T comparable]() Policy[T] {
+	return func(value T) bool {
+		return value != getZeroOfType[T]()
+	}
+}
+
+// NonBlank returns a Policy that treats empty and whitespace-only strings as absent.
+func NonBlank[S ~string]() Policy[S] {
+	return func(value S) bool {
+		return strings.TrimSpace(string(value)) != ""
+	}
+}
+
+// NotNaN returns a Policy that treats NaN as absent.
+func NotNaN[F ~float32 | ~float64]() Policy[F] {
+	return func(value F) bool {
+		return !math.IsNaN(float64(value))
+	}
+}
+
+// And returns a Policy that keeps a value iff both this and the given Policy keep it.
+// A nil Policy keeps every value.
+func (p Policy[T]) And(p2 Policy[T]) Policy[T] {
+	return func(value T) bool {
+		return p.keeps(value) && p2.keeps(value)
+	}
+}
+
+// Of attempts to return a new non-empty Optional wrapping the given value.
+// If such value is either invalid or nil, or if this Policy rejects it, it returns an empty Optional instead.
+func (p Policy[T]) Of(value T) *Optional[T] {
+	return OfFunc(value, p)
+}
+
+// Apply returns the given Optional if it is empty or if this Policy keeps its value,
+// or an empty Optional otherwise.
+func (p Policy[T]) Apply(o *Optional[T]) *Optional[T] {
+	if o.IsEmpty() || p.keeps(o.Unwrap()) {
+		return o
+	}
+
+	return Empty[T]()
+}
+
+// DecodeJSON populates the given Optional with the given JSON data, as Optional.UnmarshalJSON would,
+// leaving it empty if this Policy rejects the decoded value.
+// It is meant to be called from the UnmarshalJSON method of types embedding or wrapping an Optional.
+func (p Policy[T]) DecodeJSON(data []byte, o *Optional[T]) error {
+	err := o.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	if o.IsPresent() && !p.keeps(o.Unwrap()) {
+		o.unsetValue()
+	}
+
+	return nil
+}
+
+// Keeper decides, as a Policy does, whether a value should be kept by a PolicyOptional.
+// It is meant to be implemented by zero-size types, e.g.:
+//
+//	type Positive struct{}
+//
+//	func (Positive) Keep(value int) bool { return value > 0 }
+//
+// KeepNonZero, KeepNonBlank and KeepNotNaN mirror NonZero, NonBlank and NotNaN respectively.
+type Keeper[T any] interface {
+	Keep(T) bool
+}
+
+// KeepNonZero is a Keeper that treats the zero value of T as absent, as NonZero does.
+type KeepNonZero[T comparable] struct{}
+
+// Keep returns true if the given value is not the zero value of T.
+func (KeepNonZero[T]) Keep(value T) bool {
+	return NonZero[T]()(value)
+}
+
+// KeepNonBlank is a Keeper that treats empty and whitespace-only strings as absent, as NonBlank does.
+type KeepNonBlank[S ~string] struct{}
+
+// Keep returns true if the given value holds at least one non-whitespace character.
+func (KeepNonBlank[S]) Keep(value S) bool {
+	return NonBlank[S]()(value)
+}
+
+// KeepNotNaN is a Keeper that treats NaN as absent, as NotNaN does.
+type KeepNotNaN[F ~float32 | ~float64] struct{}
+
+// Keep returns true if the given value is not NaN.
+func (KeepNotNaN[F]) Keep(value F) bool {
+	return NotNaN[F]()(value)
+}
+
+// PolicyOptional is an Optional that applies the Keeper P when decoded from JSON,
+// so that a struct field of such type is enough to enforce the rule, e.g. PolicyOptional[string, KeepNonBlank[string]].
+// All other methods are those of the embedded Optional.
+type PolicyOptional[T any, P Keeper[T]] struct {
+	Optional[T]
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, as Optional.UnmarshalJSON does,
+// leaving this instance empty if P rejects the decoded value.
+//
+// It returns an ErrMutationOnNil error if this instance is nil.
+func (o *PolicyOptional[T, P]) UnmarshalJSON(data []byte) error {
+	if o == nil {
+		return ErrMutationOnNil
+	}
+
+	return policyOf[T, P]().DecodeJSON(data, &o.Optional)
+}
+
+func policyOf[T any, P Keeper[T]]() Policy[T] {
+	var p P
+	return p.Keep
+}
+
+func (p Policy[T]) keeps(value T) bool {
+	return p == nil || p(value)
+}
+
+// OfFunc attempts to return a new non-empty Optional wrapping the given value.
+// If such value is either invalid or nil, or if isValid returns false for it, it returns an empty Optional instead.
+// A nil isValid behaves as Of.
+func OfFunc[T any](value T, isValid func(T) bool) *Optional[T] {
+	opt := Of(value)
+	if opt.IsEmpty() || isValid == nil || isValid(value) {
+		return opt
+	}
+
+	return Empty[T]()
+}
+
+// OfNonZero attempts to return a new non-empty Optional wrapping the given value.
+// If such value is the zero value of T, it returns an empty Optional instead.
+func OfNonZero[T comparable](value T) *Optional[T] {
+	return NonZero[T]().Of(value)
+}
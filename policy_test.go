@@ -0,0 +1,146 @@
+package goptional
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type policyName string
+
+func TestOfNonZero(t *testing.T) {
+	require.True(t, OfNonZero(0).IsEmpty())
+	require.True(t, OfNonZero("").IsEmpty())
+	require.True(t, OfNonZero[*int](nil).IsEmpty())
+	require.True(t, OfNonZero(struct{ A int }{}).IsEmpty())
+
+	require.EqualValues(t, OfNonZero(123).Unwrap(), 123)
+	require.EqualValues(t, OfNonZero("gm").Unwrap(), "gm")
+	require.True(t, OfNonZero(math.NaN()).IsPresent())
+}
+
+func TestOfFunc(t *testing.T) {
+	isPositive := func(v int) bool { return v > 0 }
+
+	require.EqualValues(t, OfFunc(1, isPositive).Unwrap(), 1)
+	require.True(t, OfFunc(0, isPositive).IsEmpty())
+	require.EqualValues(t, OfFunc(0, nil).Unwrap(), 0)
+
+	called := false
+	opt := OfFunc[[]int](nil, func([]int) bool {
+		called = true
+		return true
+	})
+	require.True(t, opt.IsEmpty())
+	require.False(t, called)
+}
+
+func TestPolicy_Builtins(t *testing.T) {
+	require.True(t, NonBlank[string]().Of("").IsEmpty())
+	require.True(t, NonBlank[string]().Of(" \t\n").IsEmpty())
+	require.EqualValues(t, NonBlank[string]().Of(" gm ").Unwrap(), " gm ")
+	require.True(t, NonBlank[policyName]().Of(" ").IsEmpty())
+
+	require.True(t, NotNaN[float64]().Of(math.NaN()).IsEmpty())
+	require.True(t, NotNaN[float32]().Of(float32(math.NaN())).IsEmpty())
+	require.EqualValues(t, NotNaN[float64]().Of(0).Unwrap(), 0)
+
+	require.True(t, NonZero[float64]().Of(0).IsEmpty())
+	require.True(t, NonZero[float64]().Of(math.NaN()).IsPresent())
+}
+
+func TestPolicy_And(t *testing.T) {
+	p := NotNaN[float64]().And(NonZero[float64]())
+	require.True(t, p.Of(0).IsEmpty())
+	require.True(t, p.Of(math.NaN()).IsEmpty())
+	require.EqualValues(t, p.Of(1.5).Unwrap(), 1.5)
+
+	var none Policy[int]
+	require.EqualValues(t, none.And(nil).Of(0).Unwrap(), 0)
+	require.EqualValues(t, none.Of(0).Unwrap(), 0)
+}
+
+func TestPolicy_Apply(t *testing.T) {
+	p := NonZero[int]()
+	require.EqualValues(t, p.Apply(Of(1)).Unwrap(), 1)
+	require.True(t, p.Apply(Of(0)).IsEmpty())
+	require.True(t, p.Apply(Empty[int]()).IsEmpty())
+
+	var opt *Optional[int]
+	require.True(t, p.Apply(opt).IsEmpty())
+}
+
+func TestPolicy_DecodeJSON(t *testing.T) {
+	p := NonBlank[string]()
+
+	opt := Empty[string]()
+	require.NoError(t, p.DecodeJSON([]byte(`"gm"`), opt))
+	require.EqualValues(t, opt.Unwrap(), "gm")
+
+	require.NoError(t, p.DecodeJSON([]byte(`"  "`), opt))
+	require.True(t, opt.IsEmpty())
+
+	require.NoError(t, p.DecodeJSON([]byte(`null`), opt))
+	require.True(t, opt.IsEmpty())
+
+	require.Error(t, p.DecodeJSON([]byte(`123`), opt))
+
+	var nilOpt *Optional[string]
+	require.ErrorIs(t, p.DecodeJSON([]byte(`"gm"`), nilOpt), ErrMutationOnNil)
+}
+
+type policyPositive struct{}
+
+func (policyPositive) Keep(value int) bool {
+	return value > 0
+}
+
+type policyRequest struct {
+	Name  PolicyOptional[string, KeepNonBlank[string]] `json:"name"`
+	Count PolicyOptional[int, policyPositive]          `json:"count"`
+	Ratio PolicyOptional[float64, KeepNotNaN[float64]] `json:"ratio"`
+	Code  PolicyOptional[policyName, KeepNonZero[policyName]]
+}
+
+func TestKeepers(t *testing.T) {
+	require.True(t, KeepNonZero[int]{}.Keep(1))
+	require.False(t, KeepNonZero[int]{}.Keep(0))
+
+	require.True(t, KeepNonBlank[policyName]{}.Keep(" gm "))
+	require.False(t, KeepNonBlank[policyName]{}.Keep(" \t"))
+
+	require.True(t, KeepNotNaN[float32]{}.Keep(1))
+	require.False(t, KeepNotNaN[float64]{}.Keep(math.NaN()))
+}
+
+func TestPolicyOptional_UnmarshalJSON(t *testing.T) {
+	var req policyRequest
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"gm","count":2,"ratio":0.5,"Code":"x"}`), &req))
+	require.EqualValues(t, req.Name.Unwrap(), "gm")
+	require.EqualValues(t, req.Count.Unwrap(), 2)
+	require.EqualValues(t, req.Ratio.Unwrap(), 0.5)
+	require.EqualValues(t, req.Code.Unwrap(), "x")
+
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"  ","count":-1,"Code":""}`), &req))
+	require.True(t, req.Name.IsEmpty())
+	require.True(t, req.Count.IsEmpty())
+	require.True(t, req.Code.IsEmpty())
+	require.EqualValues(t, req.Ratio.Unwrap(), 0.5)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"ratio":null}`), &req))
+	require.True(t, req.Ratio.IsEmpty())
+
+	require.Error(t, json.Unmarshal([]byte(`{"count":"gm"}`), &req))
+
+	var nilOpt *PolicyOptional[int, policyPositive]
+	require.ErrorIs(t, nilOpt.UnmarshalJSON([]byte(`1`)), ErrMutationOnNil)
+}
+
+func TestPolicyOptional_MarshalJSON(t *testing.T) {
+	req := policyRequest{Name: PolicyOptional[string, KeepNonBlank[string]]{*Of("gm")}}
+	jsonBytes, err := json.Marshal(&req)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"gm","count":null,"ratio":null,"Code":null}`, string(jsonBytes))
+}
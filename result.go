@@ -0,0 +1,240 @@
+package goptional
+
+import "github.com/davecgh/go-spew/spew"
+
+// Result represents the outcome of an operation that may fail.
+// At any time it either holds a value (Ok) or an error (Err).
+//
+// The zero value of Result is Ok and holds the zero value of T,
+// while a nil *Result[T] is considered an Err holding ErrNoValue.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a new Result holding the given value.
+// Unlike Of, a nil value is held as is.
+func Ok[T any](value T) *Result[T] {
+	return &Result[T]{value: value}
+}
+
+// Err returns a new Result holding the given error.
+// If err is nil, ErrNoValue is held instead.
+func Err[T any](err error) *Result[T] {
+	if err == nil {
+		err = ErrNoValue
+	}
+
+	return &Result[T]{err: err}
+}
+
+// ResultOf returns a new Result from the usual (value, error) pair:
+// an Err holding err if err is not nil, or an Ok holding value otherwise.
+func ResultOf[T any](value T, err error) *Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+
+	return Ok(value)
+}
+
+// IsOk returns true if this instance holds a value, and false otherwise.
+func (r *Result[T]) IsOk() bool {
+	return r != nil && r.err == nil
+}
+
+// IsErr returns true if this instance holds an error, and false otherwise.
+func (r *Result[T]) IsErr() bool {
+	return !r.IsOk()
+}
+
+// Err returns the error held by this instance, if any, or nil otherwise.
+func (r *Result[T]) Err() error {
+	if r == nil {
+		return ErrNoValue
+	}
+
+	return r.err
+}
+
+// Ok returns an Optional holding the value of this instance, if any, or an empty Optional otherwise.
+// The error held by this instance, if any, is discarded.
+func (r *Result[T]) Ok() *Optional[T] {
+	if r.IsErr() {
+		return Empty[T]()
+	}
+
+	return Of(r.value)
+}
+
+// Val returns the value held by this instance, if any. It returns the held error otherwise.
+func (r *Result[T]) Val() (T, error) {
+	if r.IsErr() {
+		return getZeroOfType[T](), r.Err()
+	}
+
+	return r.value, nil
+}
+
+// Unwrap returns the value held by this instance, if any, or _panics_ with the held error otherwise.
+//
+// Use it only if you _know_ what you are doing.
+// Usage of OrDefault / OrElse is preferred.
+func (r *Result[T]) Unwrap() T {
+	if r.IsErr() {
+		panic(r.Err())
+	}
+
+	return r.value
+}
+
+// OrDefault returns the value held by this instance, if any, or the zero value of T otherwise.
+func (r *Result[T]) OrDefault() T {
+	if r.IsErr() {
+		return getZeroOfType[T]()
+	}
+
+	return r.value
+}
+
+// OrElse returns the value held by this instance, if any, or the given fallback value otherwise.
+func (r *Result[T]) OrElse(fallback T) T {
+	if r.IsErr() {
+		return fallback
+	}
+
+	return r.value
+}
+
+// OrElseGet returns the value held by this instance, if any, or a value provided by the given supplier otherwise.
+// The supplier is given the held error.
+//
+// If this instance holds an error and supplier is nil, it returns the zero value of T.
+func (r *Result[T]) OrElseGet(supplier func(error) T) T {
+	if r.IsOk() {
+		return r.value
+	}
+
+	if supplier == nil {
+		return getZeroOfType[T]()
+	}
+
+	return supplier(r.Err())
+}
+
+// IfOk applies the action to the value held by this instance.
+// Does nothing if this instance holds an error. If action is nil, nothing is done.
+func (r *Result[T]) IfOk(action func(T)) {
+	if r.IsOk() && action != nil {
+		action(r.value)
+	}
+}
+
+// IfOkOrElse applies the action to the value held by this instance or calls errAction with the held error.
+// If action or errAction are nil, nothing is done.
+func (r *Result[T]) IfOkOrElse(action func(T), errAction func(error)) {
+	if r.IsOk() {
+		if action != nil {
+			action(r.value)
+		}
+	} else {
+		if errAction != nil {
+			errAction(r.Err())
+		}
+	}
+}
+
+// Or returns one of the following:
+//   - this instance if it holds a value
+//   - a new Result provided by the given supplier, which is given the held error
+//
+// It returns this instance if it holds an error and supplier is nil.
+func (r *Result[T]) Or(supplier func(error) *Result[T]) *Result[T] {
+	if r.IsOk() || supplier == nil {
+		return r
+	}
+
+	return supplier(r.Err())
+}
+
+// MapErr returns one of the following:
+//   - this instance if it holds a value
+//   - a new Result holding the error that results from the application of the given mapper to the held error
+//
+// It returns this instance if it holds an error and mapper is nil.
+func (r *Result[T]) MapErr(mapper func(error) error) *Result[T] {
+	if r.IsOk() || mapper == nil {
+		return r
+	}
+
+	return Err[T](mapper(r.Err()))
+}
+
+// String returns the string representation of this instance.
+func (r *Result[T]) String() string {
+	if r.IsErr() {
+		return "Result.err[" + r.Err().Error() + "]"
+	}
+
+	return spew.Sprintf("Result.ok[%#+v]", r.value)
+}
+
+// MapResult returns one of the following:
+//   - a new Result holding the error of input if it holds an error
+//   - a new Result holding a value that results from the application of the given mapper to the value of input
+//
+// If input holds a value and mapper is nil, it returns a Result holding ErrNoValue.
+func MapResult[X, Y any](input *Result[X], mapper func(X) Y) *Result[Y] {
+	if input.IsErr() {
+		return Err[Y](input.Err())
+	}
+
+	if mapper == nil {
+		return Err[Y](ErrNoValue)
+	}
+
+	return Ok(mapper(input.value))
+}
+
+// FlatMapResult returns one of the following:
+//   - a new Result holding the error of input if it holds an error
+//   - a new Result that results from the application of the given mapper to the value of input
+//
+// If input holds a value and mapper is nil, it returns a Result holding ErrNoValue.
+func FlatMapResult[X, Y any](input *Result[X], mapper func(X) *Result[Y]) *Result[Y] {
+	if input.IsErr() {
+		return Err[Y](input.Err())
+	}
+
+	if mapper == nil {
+		return Err[Y](ErrNoValue)
+	}
+
+	return mapper(input.value)
+}
+
+// OkOr returns a Result holding the value of this instance, if any, or the given error otherwise.
+// On the other hand, if this instance is empty and err is nil, the Result holds ErrNoValue.
+func (o *Optional[T]) OkOr(err error) *Result[T] {
+	if o.IsPresent() {
+		return Ok(o.Unwrap())
+	}
+
+	return Err[T](err)
+}
+
+// OkOrElse returns a Result holding the value of this instance, if any,
+// or the error provided by the given supplier otherwise.
+//
+// If this instance is empty and supplier is either nil or returns a nil error, the Result holds ErrNoValue.
+func (o *Optional[T]) OkOrElse(supplier func() error) *Result[T] {
+	if o.IsPresent() {
+		return Ok(o.Unwrap())
+	}
+
+	if supplier == nil {
+		return Err[T](ErrNoValue)
+	}
+
+	return Err[T](supplier())
+}
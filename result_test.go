@@ -0,0 +1,194 @@
+package goptional
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errSample = errors.New("woops")
+
+func TestOk(t *testing.T) {
+	r := Ok(123)
+	require.True(t, r.IsOk())
+	require.False(t, r.IsErr())
+	require.NoError(t, r.Err())
+	require.EqualValues(t, r.Unwrap(), 123)
+
+	rNil := Ok[[]int](nil)
+	require.True(t, rNil.IsOk())
+	require.Nil(t, rNil.Unwrap())
+}
+
+func TestErr(t *testing.T) {
+	r := Err[int](errSample)
+	require.False(t, r.IsOk())
+	require.True(t, r.IsErr())
+	require.ErrorIs(t, r.Err(), errSample)
+
+	require.ErrorIs(t, Err[int](nil).Err(), ErrNoValue)
+}
+
+func TestResult_Nil(t *testing.T) {
+	var r *Result[int]
+	require.True(t, r.IsErr())
+	require.ErrorIs(t, r.Err(), ErrNoValue)
+	require.True(t, r.Ok().IsEmpty())
+	require.EqualValues(t, r.OrElse(7), 7)
+}
+
+func TestResult_ZeroInst(t *testing.T) {
+	var r Result[string]
+	require.True(t, r.IsOk())
+	require.EqualValues(t, r.Unwrap(), "")
+}
+
+func TestResultOf(t *testing.T) {
+	r := ResultOf(strconv.Atoi("123"))
+	require.EqualValues(t, r.Unwrap(), 123)
+
+	r = ResultOf(strconv.Atoi("gm"))
+	require.True(t, r.IsErr())
+	require.ErrorIs(t, r.Err(), strconv.ErrSyntax)
+}
+
+func TestResult_Ok(t *testing.T) {
+	require.EqualValues(t, Ok(123).Ok().Unwrap(), 123)
+	require.True(t, Ok[*int](nil).Ok().IsEmpty())
+	require.True(t, Err[int](errSample).Ok().IsEmpty())
+}
+
+func TestResult_Val(t *testing.T) {
+	v, err := Ok(123).Val()
+	require.NoError(t, err)
+	require.EqualValues(t, v, 123)
+
+	v, err = Err[int](errSample).Val()
+	require.ErrorIs(t, err, errSample)
+	require.EqualValues(t, v, 0)
+}
+
+func TestResult_UnwrapErr(t *testing.T) {
+	defer func() {
+		r := recover()
+		require.NotNil(t, r)
+		require.ErrorIs(t, r.(error), errSample)
+	}()
+	_ = Err[int](errSample).Unwrap()
+}
+
+func TestResult_OrDefault(t *testing.T) {
+	require.EqualValues(t, Ok(123).OrDefault(), 123)
+	require.EqualValues(t, Err[int](errSample).OrDefault(), 0)
+}
+
+func TestResult_OrElse(t *testing.T) {
+	require.EqualValues(t, Ok(123).OrElse(321), 123)
+	require.EqualValues(t, Err[int](errSample).OrElse(321), 321)
+}
+
+func TestResult_OrElseGet(t *testing.T) {
+	require.EqualValues(t, Ok(123).OrElseGet(nil), 123)
+	require.EqualValues(t, Err[int](errSample).OrElseGet(nil), 0)
+
+	v := Err[string](errSample).OrElseGet(func(err error) string { return err.Error() })
+	require.EqualValues(t, v, "woops")
+}
+
+func TestResult_IfOk(t *testing.T) {
+	v := 0
+	Ok(123).IfOk(func(x int) { v = x })
+	require.EqualValues(t, v, 123)
+
+	Err[int](errSample).IfOk(func(x int) { v = -1 })
+	require.EqualValues(t, v, 123)
+
+	Ok(123).IfOk(nil)
+}
+
+func TestResult_IfOkOrElse(t *testing.T) {
+	var okCalled bool
+	var errCalled error
+	Ok(123).IfOkOrElse(func(_ int) { okCalled = true }, func(err error) { errCalled = err })
+	require.True(t, okCalled)
+	require.NoError(t, errCalled)
+
+	okCalled = false
+	Err[int](errSample).IfOkOrElse(func(_ int) { okCalled = true }, func(err error) { errCalled = err })
+	require.False(t, okCalled)
+	require.ErrorIs(t, errCalled, errSample)
+
+	Ok(123).IfOkOrElse(nil, nil)
+	Err[int](errSample).IfOkOrElse(nil, nil)
+}
+
+func TestResult_Or(t *testing.T) {
+	r := Ok(123)
+	require.Same(t, r.Or(func(error) *Result[int] { return Ok(321) }), r)
+
+	r = Err[int](errSample)
+	require.Same(t, r.Or(nil), r)
+	require.EqualValues(t, r.Or(func(error) *Result[int] { return Ok(321) }).Unwrap(), 321)
+}
+
+func TestResult_MapErr(t *testing.T) {
+	r := Ok(123)
+	require.Same(t, r.MapErr(func(err error) error { return nil }), r)
+
+	wrap := func(err error) error { return fmt.Errorf("wrapped: %w", err) }
+	r = Err[int](errSample).MapErr(wrap)
+	require.ErrorIs(t, r.Err(), errSample)
+	require.EqualValues(t, r.Err().Error(), "wrapped: woops")
+
+	r = Err[int](errSample)
+	require.Same(t, r.MapErr(nil), r)
+}
+
+func TestResult_String(t *testing.T) {
+	require.EqualValues(t, Ok(123).String(), "Result.ok[(int)123]")
+	require.EqualValues(t, Err[int](errSample).String(), "Result.err[woops]")
+}
+
+func TestMapResult(t *testing.T) {
+	r := MapResult(Ok(123), strconv.Itoa)
+	require.EqualValues(t, r.Unwrap(), "123")
+
+	r = MapResult(Err[int](errSample), strconv.Itoa)
+	require.ErrorIs(t, r.Err(), errSample)
+
+	r = MapResult[int, string](Ok(123), nil)
+	require.ErrorIs(t, r.Err(), ErrNoValue)
+
+	r = MapResult(nil, strconv.Itoa)
+	require.ErrorIs(t, r.Err(), ErrNoValue)
+}
+
+func TestFlatMapResult(t *testing.T) {
+	atoi := func(s string) *Result[int] { return ResultOf(strconv.Atoi(s)) }
+
+	require.EqualValues(t, FlatMapResult(Ok("123"), atoi).Unwrap(), 123)
+	require.ErrorIs(t, FlatMapResult(Ok("gm"), atoi).Err(), strconv.ErrSyntax)
+	require.ErrorIs(t, FlatMapResult(Err[string](errSample), atoi).Err(), errSample)
+	require.ErrorIs(t, FlatMapResult[string, int](Ok("123"), nil).Err(), ErrNoValue)
+}
+
+func TestOkOr(t *testing.T) {
+	require.EqualValues(t, Of(123).OkOr(errSample).Unwrap(), 123)
+	require.ErrorIs(t, Empty[int]().OkOr(errSample).Err(), errSample)
+	require.ErrorIs(t, Empty[int]().OkOr(nil).Err(), ErrNoValue)
+}
+
+func TestOkOrElse(t *testing.T) {
+	require.EqualValues(t, Of(123).OkOrElse(nil).Unwrap(), 123)
+	require.ErrorIs(t, Empty[int]().OkOrElse(func() error { return errSample }).Err(), errSample)
+	require.ErrorIs(t, Empty[int]().OkOrElse(func() error { return nil }).Err(), ErrNoValue)
+	require.ErrorIs(t, Empty[int]().OkOrElse(nil).Err(), ErrNoValue)
+}
+
+func TestResult_OptionalRoundTrip(t *testing.T) {
+	require.True(t, Of(123).OkOr(errSample).Ok().Equals(Of(123)))
+	require.True(t, Empty[int]().OkOr(errSample).Ok().IsEmpty())
+}
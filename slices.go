@@ -0,0 +1,70 @@
+package goptional
+
+// Compact returns the values of the non-empty Optionals in opts, preserving their order.
+func Compact[T any](opts []*Optional[T]) []T {
+	values := make([]T, 0, len(opts))
+	for _, o := range opts {
+		if o.IsPresent() {
+			values = append(values, o.Unwrap())
+		}
+	}
+
+	return values
+}
+
+// Sequence returns one of the following:
+//   - an empty Optional if at least one of opts is empty
+//   - a new Optional holding the values of opts, preserving their order
+//
+// If opts has no elements, it returns an Optional holding an empty slice.
+func Sequence[T any](opts []*Optional[T]) *Optional[[]T] {
+	values := make([]T, 0, len(opts))
+	for _, o := range opts {
+		if o.IsEmpty() {
+			return Empty[[]T]()
+		}
+		values = append(values, o.Unwrap())
+	}
+
+	return Of(values)
+}
+
+// Traverse applies the given mapper to each element of input and returns one of the following:
+//   - an empty Optional if the mapper returns an empty Optional for at least one element
+//   - a new Optional holding the mapped values, preserving their order
+//
+// It stops at the first empty Optional returned by mapper.
+// If input has no elements, it returns an Optional holding an empty slice.
+// If mapper is nil, it returns an empty Optional.
+func Traverse[X, Y any](input []X, mapper func(X) *Optional[Y]) *Optional[[]Y] {
+	if mapper == nil {
+		return Empty[[]Y]()
+	}
+
+	values := make([]Y, 0, len(input))
+	for _, x := range input {
+		o := mapper(x)
+		if o.IsEmpty() {
+			return Empty[[]Y]()
+		}
+		values = append(values, o.Unwrap())
+	}
+
+	return Of(values)
+}
+
+// Partition splits opts into the values of its non-empty Optionals and the indices of its empty ones,
+// both preserving their order.
+func Partition[T any](opts []*Optional[T]) (values []T, emptyIndices []int) {
+	values = make([]T, 0, len(opts))
+	emptyIndices = make([]int, 0)
+	for i, o := range opts {
+		if o.IsPresent() {
+			values = append(values, o.Unwrap())
+		} else {
+			emptyIndices = append(emptyIndices, i)
+		}
+	}
+
+	return values, emptyIndices
+}
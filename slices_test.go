@@ -0,0 +1,71 @@
+package goptional
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompact(t *testing.T) {
+	require.EqualValues(t, Compact([]*Optional[int]{Of(1), Empty[int](), nil, Of(2)}), []int{1, 2})
+	require.EqualValues(t, Compact([]*Optional[int]{Empty[int]()}), []int{})
+	require.EqualValues(t, Compact[int](nil), []int{})
+}
+
+func TestSequence_AllPresent(t *testing.T) {
+	opt := Sequence([]*Optional[string]{Of("a"), Of(""), Of("c")})
+	require.True(t, opt.IsPresent())
+	require.EqualValues(t, opt.Unwrap(), []string{"a", "", "c"})
+}
+
+func TestSequence_SomeEmpty(t *testing.T) {
+	require.True(t, Sequence([]*Optional[int]{Of(1), Empty[int]()}).IsEmpty())
+	require.True(t, Sequence([]*Optional[int]{nil, Of(1)}).IsEmpty())
+}
+
+func TestSequence_NoElements(t *testing.T) {
+	opt := Sequence[int](nil)
+	require.True(t, opt.IsPresent())
+	require.EqualValues(t, opt.Unwrap(), []int{})
+}
+
+func TestTraverse_AllPresent(t *testing.T) {
+	atoi := func(s string) *Optional[int] { return FromErr(strconv.Atoi(s)) }
+
+	opt := Traverse([]string{"1", "2", "3"}, atoi)
+	require.True(t, opt.IsPresent())
+	require.EqualValues(t, opt.Unwrap(), []int{1, 2, 3})
+}
+
+func TestTraverse_SomeEmpty(t *testing.T) {
+	calls := 0
+	atoi := func(s string) *Optional[int] {
+		calls++
+		return FromErr(strconv.Atoi(s))
+	}
+
+	require.True(t, Traverse([]string{"1", "gm", "3"}, atoi).IsEmpty())
+	require.EqualValues(t, calls, 2)
+}
+
+func TestTraverse_NoElements(t *testing.T) {
+	opt := Traverse([]string{}, func(s string) *Optional[int] { return Empty[int]() })
+	require.True(t, opt.IsPresent())
+	require.EqualValues(t, opt.Unwrap(), []int{})
+}
+
+func TestTraverse_NilMapper(t *testing.T) {
+	require.True(t, Traverse[string, int]([]string{"1"}, nil).IsEmpty())
+	require.True(t, Traverse[string, int](nil, nil).IsEmpty())
+}
+
+func TestPartition(t *testing.T) {
+	values, emptyIndices := Partition([]*Optional[int]{Empty[int](), Of(1), nil, Of(2)})
+	require.EqualValues(t, values, []int{1, 2})
+	require.EqualValues(t, emptyIndices, []int{0, 2})
+
+	values, emptyIndices = Partition[int](nil)
+	require.EqualValues(t, values, []int{})
+	require.EqualValues(t, emptyIndices, []int{})
+}
@@ -0,0 +1,331 @@
+package goptional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// ErrUnsupportedScan indicates that a driver value cannot be stored into the type held by an Optional.
+var ErrUnsupportedScan = errors.New("unsupported scan")
+
+// Scan implements the sql.Scanner interface.
+// A NULL value leaves this instance empty, while any other value is converted into T
+// following the same rules database/sql applies when scanning into a plain T.
+//
+// It returns an ErrMutationOnNil error if this instance is nil.
+func (o *Optional[T]) Scan(src interface{}) error {
+	if o == nil {
+		return ErrMutationOnNil
+	}
+
+	if src == nil {
+		o.unsetValue()
+		return nil
+	}
+
+	var value T
+	if err := convertAssign(&value, src); err != nil {
+		return err
+	}
+	o.setValue(value)
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// An empty Optional is stored as NULL, while a present value is converted
+// through its own driver.Valuer, if any, or through driver.DefaultParameterConverter otherwise.
+func (o *Optional[T]) Value() (driver.Value, error) {
+	if o.IsEmpty() {
+		return nil, nil
+	}
+
+	v := o.Unwrap()
+	if valuer, ok := interface{}(v).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(v)
+}
+
+// convertAssign stores the non-nil driver value src into dest.
+// It mirrors the conversion rules database/sql uses in Rows.Scan.
+func convertAssign(dest, src interface{}) error {
+	switch s := src.(type) {
+	case string:
+		switch d := dest.(type) {
+		case *string:
+			*d = s
+			return nil
+		case *[]byte:
+			*d = []byte(s)
+			return nil
+		}
+	case []byte:
+		switch d := dest.(type) {
+		case *string:
+			*d = string(s)
+			return nil
+		case *interface{}:
+			*d = cloneBytes(s)
+			return nil
+		case *[]byte:
+			*d = cloneBytes(s)
+			return nil
+		}
+	case time.Time:
+		switch d := dest.(type) {
+		case *time.Time:
+			*d = s
+			return nil
+		case *string:
+			*d = s.Format(time.RFC3339Nano)
+			return nil
+		case *[]byte:
+			*d = []byte(s.Format(time.RFC3339Nano))
+			return nil
+		}
+	}
+
+	var sv reflect.Value
+
+	switch d := dest.(type) {
+	case *string:
+		sv = reflect.ValueOf(src)
+		switch sv.Kind() {
+		case reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			*d = asString(src)
+			return nil
+		}
+	case *[]byte:
+		sv = reflect.ValueOf(src)
+		switch sv.Kind() {
+		case reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			*d = []byte(asString(src))
+			return nil
+		}
+	case *bool:
+		bv, err := driver.Bool.ConvertValue(src)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrUnsupportedScan, err)
+		}
+		*d = bv.(bool)
+		return nil
+	case *interface{}:
+		*d = src
+		return nil
+	}
+
+	if scanner, ok := dest.(sql.Scanner); ok {
+		return scanner.Scan(src)
+	}
+
+	if !sv.IsValid() {
+		sv = reflect.ValueOf(src)
+	}
+
+	dv := reflect.ValueOf(dest).Elem()
+	if sv.Type().AssignableTo(dv.Type()) {
+		if b, ok := src.([]byte); ok {
+			dv.Set(reflect.ValueOf(cloneBytes(b)))
+		} else {
+			dv.Set(sv)
+		}
+		return nil
+	}
+
+	if dv.Kind() == sv.Kind() && sv.Type().ConvertibleTo(dv.Type()) {
+		dv.Set(sv.Convert(dv.Type()))
+		return nil
+	}
+
+	// Numeric conversions go through a string representation, as database/sql does.
+	// This also covers user-defined types such as "type ID int64".
+	switch dv.Kind() {
+	case reflect.Ptr:
+		dv.Set(reflect.New(dv.Type().Elem()))
+		return convertAssign(dv.Interface(), src)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s := asString(src)
+		i64, err := strconv.ParseInt(s, 10, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: converting driver.Value type %T (%q) to a %s: %v", ErrUnsupportedScan, src, s, dv.Kind(), strconvErr(err))
+		}
+		dv.SetInt(i64)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s := asString(src)
+		u64, err := strconv.ParseUint(s, 10, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: converting driver.Value type %T (%q) to a %s: %v", ErrUnsupportedScan, src, s, dv.Kind(), strconvErr(err))
+		}
+		dv.SetUint(u64)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		s := asString(src)
+		f64, err := strconv.ParseFloat(s, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w: converting driver.Value type %T (%q) to a %s: %v", ErrUnsupportedScan, src, s, dv.Kind(), strconvErr(err))
+		}
+		dv.SetFloat(f64)
+		return nil
+	case reflect.String:
+		switch v := src.(type) {
+		case string:
+			dv.SetString(v)
+			return nil
+		case []byte:
+			dv.SetString(string(v))
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: storing driver.Value type %T into type %T", ErrUnsupportedScan, src, dest)
+}
+
+// asString returns the textual form of a driver value, as database/sql does.
+func asString(src interface{}) string {
+	switch v := src.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	}
+
+	rv := reflect.ValueOf(src)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 64)
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 32)
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	}
+
+	return fmt.Sprintf("%v", src)
+}
+
+// strconvErr strips the redundant input echoed by strconv errors.
+func strconvErr(err error) error {
+	var ne *strconv.NumError
+	if errors.As(err, &ne) {
+		return ne.Err
+	}
+
+	return err
+}
+
+// cloneBytes returns a copy of b, as drivers may reuse the underlying buffer.
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+// FromNullString returns an Optional holding the string of n if n is valid, or an empty Optional otherwise.
+func FromNullString(n sql.NullString) *Optional[string] {
+	return fromNull(n.String, n.Valid)
+}
+
+// ToNullString returns a valid sql.NullString holding the value of o, if any, or an invalid one otherwise.
+func ToNullString(o *Optional[string]) sql.NullString {
+	return sql.NullString{String: o.OrDefault(), Valid: o.IsPresent()}
+}
+
+// FromNullInt64 returns an Optional holding the int64 of n if n is valid, or an empty Optional otherwise.
+func FromNullInt64(n sql.NullInt64) *Optional[int64] {
+	return fromNull(n.Int64, n.Valid)
+}
+
+// ToNullInt64 returns a valid sql.NullInt64 holding the value of o, if any, or an invalid one otherwise.
+func ToNullInt64(o *Optional[int64]) sql.NullInt64 {
+	return sql.NullInt64{Int64: o.OrDefault(), Valid: o.IsPresent()}
+}
+
+// FromNullInt32 returns an Optional holding the int32 of n if n is valid, or an empty Optional otherwise.
+func FromNullInt32(n sql.NullInt32) *Optional[int32] {
+	return fromNull(n.Int32, n.Valid)
+}
+
+// ToNullInt32 returns a valid sql.NullInt32 holding the value of o, if any, or an invalid one otherwise.
+func ToNullInt32(o *Optional[int32]) sql.NullInt32 {
+	return sql.NullInt32{Int32: o.OrDefault(), Valid: o.IsPresent()}
+}
+
+// FromNullInt16 returns an Optional holding the int16 of n if n is valid, or an empty Optional otherwise.
+func FromNullInt16(n sql.NullInt16) *Optional[int16] {
+	return fromNull(n.Int16, n.Valid)
+}
+
+// ToNullInt16 returns a valid sql.NullInt16 holding the value of o, if any, or an invalid one otherwise.
+func ToNullInt16(o *Optional[int16]) sql.NullInt16 {
+	return sql.NullInt16{Int16: o.OrDefault(), Valid: o.IsPresent()}
+}
+
+// FromNullByte returns an Optional holding the byte of n if n is valid, or an empty Optional otherwise.
+func FromNullByte(n sql.NullByte) *Optional[byte] {
+	return fromNull(n.Byte, n.Valid)
+}
+
+// ToNullByte returns a valid sql.NullByte holding the value of o, if any, or an invalid one otherwise.
+func ToNullByte(o *Optional[byte]) sql.NullByte {
+	return sql.NullByte{Byte: o.OrDefault(), Valid: o.IsPresent()}
+}
+
+// FromNullFloat64 returns an Optional holding the float64 of n if n is valid, or an empty Optional otherwise.
+func FromNullFloat64(n sql.NullFloat64) *Optional[float64] {
+	return fromNull(n.Float64, n.Valid)
+}
+
+// ToNullFloat64 returns a valid sql.NullFloat64 holding the value of o, if any, or an invalid one otherwise.
+func ToNullFloat64(o *Optional[float64]) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: o.OrDefault(), Valid: o.IsPresent()}
+}
+
+// FromNullBool returns an Optional holding the bool of n if n is valid, or an empty Optional otherwise.
+func FromNullBool(n sql.NullBool) *Optional[bool] {
+	return fromNull(n.Bool, n.Valid)
+}
+
+// ToNullBool returns a valid sql.NullBool holding the value of o, if any, or an invalid one otherwise.
+func ToNullBool(o *Optional[bool]) sql.NullBool {
+	return sql.NullBool{Bool: o.OrDefault(), Valid: o.IsPresent()}
+}
+
+// FromNullTime returns an Optional holding the time of n if n is valid, or an empty Optional otherwise.
+func FromNullTime(n sql.NullTime) *Optional[time.Time] {
+	return fromNull(n.Time, n.Valid)
+}
+
+// ToNullTime returns a valid sql.NullTime holding the value of o, if any, or an invalid one otherwise.
+func ToNullTime(o *Optional[time.Time]) sql.NullTime {
+	return sql.NullTime{Time: o.OrDefault(), Valid: o.IsPresent()}
+}
+
+// fromNull returns an Optional holding value if valid is true, or an empty Optional otherwise.
+func fromNull[T any](value T, valid bool) *Optional[T] {
+	if !valid {
+		return Empty[T]()
+	}
+
+	return Of(value)
+}
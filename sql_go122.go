@@ -0,0 +1,17 @@
+//go:build go1.22
+
+package goptional
+
+import "database/sql"
+
+// FromNull returns an Optional holding the value of n if n is valid, or an empty Optional otherwise.
+//
+// Note that a valid sql.Null holding a nil value results in an empty Optional, as it would with Of.
+func FromNull[T any](n sql.Null[T]) *Optional[T] {
+	return fromNull(n.V, n.Valid)
+}
+
+// ToNull returns a valid sql.Null holding the value of o, if any, or an invalid one otherwise.
+func ToNull[T any](o *Optional[T]) sql.Null[T] {
+	return sql.Null[T]{V: o.OrDefault(), Valid: o.IsPresent()}
+}
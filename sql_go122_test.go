@@ -0,0 +1,29 @@
+//go:build go1.22
+
+package goptional
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromNullGeneric_Invalid(t *testing.T) {
+	require.True(t, FromNull(sql.Null[int]{V: 123}).IsEmpty())
+}
+
+func TestFromNullGeneric_Valid(t *testing.T) {
+	require.EqualValues(t, FromNull(sql.Null[int]{V: 0, Valid: true}).Unwrap(), 0)
+	require.EqualValues(t, FromNull(sql.Null[[]string]{V: []string{"gm"}, Valid: true}).Unwrap(), []string{"gm"})
+	require.True(t, FromNull(sql.Null[*int]{V: nil, Valid: true}).IsEmpty())
+}
+
+func TestToNullGeneric(t *testing.T) {
+	require.EqualValues(t, ToNull(Empty[int]()), sql.Null[int]{})
+	require.EqualValues(t, ToNull[int](nil), sql.Null[int]{})
+	require.EqualValues(t, ToNull(Of(123)), sql.Null[int]{V: 123, Valid: true})
+
+	n := sql.Null[string]{V: "gm", Valid: true}
+	require.EqualValues(t, ToNull(FromNull(n)), n)
+}
@@ -0,0 +1,264 @@
+package goptional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// echoDriver is a fake database/sql driver whose queries return
+// a single row made of the arguments they were given.
+type echoDriver struct{}
+
+type echoConn struct{}
+
+type echoStmt struct{}
+
+type echoRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (echoDriver) Open(string) (driver.Conn, error) { return echoConn{}, nil }
+
+func (echoConn) Prepare(string) (driver.Stmt, error) { return echoStmt{}, nil }
+func (echoConn) Close() error                        { return nil }
+func (echoConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (echoStmt) Close() error  { return nil }
+func (echoStmt) NumInput() int { return -1 }
+func (echoStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{values: args}, nil
+}
+
+func (r *echoRows) Columns() []string {
+	cols := make([]string, len(r.values))
+	for i := range cols {
+		cols[i] = "c" + strconv.Itoa(i)
+	}
+	return cols
+}
+func (r *echoRows) Close() error { return nil }
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
+
+func init() {
+	sql.Register("goptional-echo", echoDriver{})
+}
+
+func openEchoDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("goptional-echo", "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+type celsius float64
+
+type upperString string
+
+func (s upperString) Value() (driver.Value, error) {
+	return "UPPER:" + string(s), nil
+}
+
+func TestScan_Nil(t *testing.T) {
+	var opt *Optional[int]
+	require.ErrorIs(t, opt.Scan(int64(123)), ErrMutationOnNil)
+}
+
+func TestScan_NullOnNotEmpty(t *testing.T) {
+	opt := Of(123)
+	require.NoError(t, opt.Scan(nil))
+	require.True(t, opt.IsEmpty())
+}
+
+func TestScan_DriverValues(t *testing.T) {
+	optInt := Empty[int]()
+	require.NoError(t, optInt.Scan(int64(123)))
+	require.EqualValues(t, optInt.Unwrap(), 123)
+
+	require.NoError(t, optInt.Scan([]byte("321")))
+	require.EqualValues(t, optInt.Unwrap(), 321)
+
+	optStr := Empty[string]()
+	require.NoError(t, optStr.Scan([]byte("gm")))
+	require.EqualValues(t, optStr.Unwrap(), "gm")
+
+	require.NoError(t, optStr.Scan(int64(42)))
+	require.EqualValues(t, optStr.Unwrap(), "42")
+
+	optBool := Empty[bool]()
+	require.NoError(t, optBool.Scan(int64(1)))
+	require.True(t, optBool.Unwrap())
+
+	optFloat := Empty[celsius]()
+	require.NoError(t, optFloat.Scan("21.5"))
+	require.EqualValues(t, optFloat.Unwrap(), 21.5)
+
+	optUint := Empty[uint8]()
+	require.NoError(t, optUint.Scan(int64(255)))
+	require.EqualValues(t, optUint.Unwrap(), 255)
+
+	now := time.Now()
+	optTime := Empty[time.Time]()
+	require.NoError(t, optTime.Scan(now))
+	require.EqualValues(t, optTime.Unwrap(), now)
+
+	optPtr := Empty[*int64]()
+	require.NoError(t, optPtr.Scan(int64(7)))
+	require.EqualValues(t, *optPtr.Unwrap(), 7)
+}
+
+func TestScan_BytesAreCopied(t *testing.T) {
+	src := []byte("gm")
+	opt := Empty[[]byte]()
+	require.NoError(t, opt.Scan(src))
+
+	src[0] = 'x'
+	require.EqualValues(t, opt.Unwrap(), []byte("gm"))
+}
+
+func TestScan_DelegatesToScanner(t *testing.T) {
+	opt := Empty[sql.NullString]()
+	require.NoError(t, opt.Scan("gm"))
+	require.EqualValues(t, opt.Unwrap(), sql.NullString{String: "gm", Valid: true})
+}
+
+func TestScan_Mismatch(t *testing.T) {
+	opt := Of(123)
+	err := opt.Scan("gm")
+	require.ErrorIs(t, err, ErrUnsupportedScan)
+	require.EqualValues(t, opt.Unwrap(), 123)
+
+	optBool := Empty[bool]()
+	require.ErrorIs(t, optBool.Scan("maybe"), ErrUnsupportedScan)
+	require.True(t, optBool.IsEmpty())
+
+	optStruct := Empty[sampleStruct]()
+	require.ErrorIs(t, optStruct.Scan(int64(1)), ErrUnsupportedScan)
+}
+
+func TestValue_Empty(t *testing.T) {
+	v, err := Empty[int]().Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	var opt *Optional[int]
+	v, err = opt.Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+}
+
+func TestValue_NotEmpty(t *testing.T) {
+	v, err := Of(123).Value()
+	require.NoError(t, err)
+	require.EqualValues(t, v, int64(123))
+
+	v, err = Of(celsius(21.5)).Value()
+	require.NoError(t, err)
+	require.EqualValues(t, v, float64(21.5))
+
+	v, err = Of(upperString("gm")).Value()
+	require.NoError(t, err)
+	require.EqualValues(t, v, "UPPER:gm")
+
+	_, err = Of(sampleStruct{}).Value()
+	require.Error(t, err)
+}
+
+func TestSQL_RoundTrip(t *testing.T) {
+	db := openEchoDB(t)
+
+	var (
+		optInt  Optional[int]
+		optStr  = Of("stale")
+		optTime Optional[time.Time]
+	)
+	now := time.Now().UTC()
+	err := db.QueryRow("echo", Of(123), Empty[string](), Of(now)).Scan(&optInt, optStr, &optTime)
+	require.NoError(t, err)
+
+	require.EqualValues(t, optInt.Unwrap(), 123)
+	require.True(t, optStr.IsEmpty())
+	require.EqualValues(t, optTime.Unwrap(), now)
+}
+
+func TestSQL_ScanNullIntoNilField(t *testing.T) {
+	db := openEchoDB(t)
+
+	var opt *Optional[string]
+	require.NoError(t, db.QueryRow("echo", nil).Scan(&opt))
+	require.True(t, opt.IsEmpty())
+
+	require.NoError(t, db.QueryRow("echo", "gm").Scan(&opt))
+	require.EqualValues(t, opt.Unwrap(), "gm")
+}
+
+func TestFromNull_Invalid(t *testing.T) {
+	require.True(t, FromNullString(sql.NullString{String: "gm"}).IsEmpty())
+	require.True(t, FromNullInt64(sql.NullInt64{Int64: 1}).IsEmpty())
+	require.True(t, FromNullInt32(sql.NullInt32{Int32: 1}).IsEmpty())
+	require.True(t, FromNullInt16(sql.NullInt16{Int16: 1}).IsEmpty())
+	require.True(t, FromNullByte(sql.NullByte{Byte: 1}).IsEmpty())
+	require.True(t, FromNullFloat64(sql.NullFloat64{Float64: 1}).IsEmpty())
+	require.True(t, FromNullBool(sql.NullBool{Bool: true}).IsEmpty())
+	require.True(t, FromNullTime(sql.NullTime{Time: time.Now()}).IsEmpty())
+}
+
+func TestFromNull_Valid(t *testing.T) {
+	now := time.Now()
+
+	require.EqualValues(t, FromNullString(sql.NullString{String: "", Valid: true}).Unwrap(), "")
+	require.EqualValues(t, FromNullInt64(sql.NullInt64{Int64: 64, Valid: true}).Unwrap(), 64)
+	require.EqualValues(t, FromNullInt32(sql.NullInt32{Int32: 32, Valid: true}).Unwrap(), 32)
+	require.EqualValues(t, FromNullInt16(sql.NullInt16{Int16: 16, Valid: true}).Unwrap(), 16)
+	require.EqualValues(t, FromNullByte(sql.NullByte{Byte: 8, Valid: true}).Unwrap(), 8)
+	require.EqualValues(t, FromNullFloat64(sql.NullFloat64{Float64: 1.5, Valid: true}).Unwrap(), 1.5)
+	require.False(t, FromNullBool(sql.NullBool{Bool: false, Valid: true}).Unwrap())
+	require.EqualValues(t, FromNullTime(sql.NullTime{Time: now, Valid: true}).Unwrap(), now)
+}
+
+func TestToNull_Empty(t *testing.T) {
+	var nilOpt *Optional[string]
+
+	require.EqualValues(t, ToNullString(nilOpt), sql.NullString{})
+	require.EqualValues(t, ToNullString(Empty[string]()), sql.NullString{})
+	require.EqualValues(t, ToNullInt64(Empty[int64]()), sql.NullInt64{})
+	require.EqualValues(t, ToNullInt32(Empty[int32]()), sql.NullInt32{})
+	require.EqualValues(t, ToNullInt16(Empty[int16]()), sql.NullInt16{})
+	require.EqualValues(t, ToNullByte(Empty[byte]()), sql.NullByte{})
+	require.EqualValues(t, ToNullFloat64(Empty[float64]()), sql.NullFloat64{})
+	require.EqualValues(t, ToNullBool(Empty[bool]()), sql.NullBool{})
+	require.EqualValues(t, ToNullTime(Empty[time.Time]()), sql.NullTime{})
+}
+
+func TestToNull_RoundTrip(t *testing.T) {
+	now := time.Now()
+
+	require.True(t, FromNullString(ToNullString(Of(""))).Equals(Of("")))
+	require.True(t, FromNullInt64(ToNullInt64(Of[int64](64))).Equals(Of[int64](64)))
+	require.True(t, FromNullInt32(ToNullInt32(Of[int32](32))).Equals(Of[int32](32)))
+	require.True(t, FromNullInt16(ToNullInt16(Of[int16](16))).Equals(Of[int16](16)))
+	require.True(t, FromNullByte(ToNullByte(Of[byte](8))).Equals(Of[byte](8)))
+	require.True(t, FromNullFloat64(ToNullFloat64(Of(1.5))).Equals(Of(1.5)))
+	require.True(t, FromNullBool(ToNullBool(Of(false))).Equals(Of(false)))
+	require.True(t, FromNullTime(ToNullTime(Of(now))).Equals(Of(now)))
+
+	n := sql.NullString{String: "gm", Valid: true}
+	require.EqualValues(t, ToNullString(FromNullString(n)), n)
+}
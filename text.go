@@ -0,0 +1,219 @@
+package goptional
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ErrUnsupportedText indicates that the type held by an Optional has no text representation.
+var ErrUnsupportedText = errors.New("unsupported text conversion")
+
+// EmptyText is the text representation of an empty Optional.
+//
+// Note that a present value whose text equals EmptyText is decoded as empty.
+// Use TextOptional to pick a different representation for a given field.
+const EmptyText = ""
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns EmptyText if this instance is empty, or the text of its value otherwise.
+// The value is encoded through its own encoding.TextMarshaler, if any,
+// or through strconv if it is a string, bool or number.
+func (o *Optional[T]) MarshalText() ([]byte, error) {
+	return o.marshalText(EmptyText)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It leaves this instance empty if text equals EmptyText, or decodes text into a value of type T otherwise,
+// following the rules of MarshalText.
+//
+// It returns an ErrMutationOnNil error if this instance is nil.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	return o.unmarshalText(text, EmptyText)
+}
+
+// EmptyTexter provides the text representation of an empty TextOptional.
+// It is meant to be implemented by zero-size types, e.g.:
+//
+//	type Dash struct{}
+//
+//	func (Dash) EmptyText() string { return "-" }
+type EmptyTexter interface {
+	EmptyText() string
+}
+
+// TextOptional is an Optional whose empty state is represented as text by the EmptyText of S
+// rather than by the package-wide EmptyText, e.g. TextOptional[int, Dash].
+// All other methods are those of the embedded Optional.
+type TextOptional[T any, S EmptyTexter] struct {
+	Optional[T]
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, as Optional.MarshalText does,
+// returning the EmptyText of S if this instance is empty.
+func (o *TextOptional[T, S]) MarshalText() ([]byte, error) {
+	if o == nil {
+		return []byte(emptyTextOf[S]()), nil
+	}
+
+	return o.Optional.marshalText(emptyTextOf[S]())
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, as Optional.UnmarshalText does,
+// leaving this instance empty if text equals the EmptyText of S.
+//
+// It returns an ErrMutationOnNil error if this instance is nil.
+func (o *TextOptional[T, S]) UnmarshalText(text []byte) error {
+	if o == nil {
+		return ErrMutationOnNil
+	}
+
+	return o.Optional.unmarshalText(text, emptyTextOf[S]())
+}
+
+// MapKey is an Optional meant to be used as a map key, e.g. map[MapKey[int]]V,
+// including in maps encoded as JSON objects.
+// All other methods are those of the embedded Optional.
+type MapKey[T any] struct {
+	Optional[T]
+}
+
+// KeyOf returns a new MapKey holding the given value.
+// If such value is either invalid or nil, it returns an empty MapKey instead.
+func KeyOf[T any](value T) MapKey[T] {
+	var k MapKey[T]
+	k.setValue(value)
+	return k
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, as Optional.MarshalText does.
+//
+// Unlike other methods, MarshalText has a value receiver, as map keys are never addressable:
+// encoding/json only encodes keys whose type implements encoding.TextMarshaler.
+func (k MapKey[T]) MarshalText() ([]byte, error) {
+	return k.Optional.MarshalText()
+}
+
+func emptyTextOf[S EmptyTexter]() string {
+	var s S
+	return s.EmptyText()
+}
+
+// marshalText returns the text representation of this instance, using the given text if it is empty.
+func (o *Optional[T]) marshalText(empty string) ([]byte, error) {
+	if o.IsEmpty() {
+		return []byte(empty), nil
+	}
+
+	return marshalText(reflect.ValueOf(&o.value).Elem())
+}
+
+// unmarshalText decodes text into this instance, leaving it empty if text equals the given one.
+func (o *Optional[T]) unmarshalText(text []byte, empty string) error {
+	if o == nil {
+		return ErrMutationOnNil
+	}
+
+	if string(text) == empty {
+		o.unsetValue()
+		return nil
+	}
+
+	var value T
+	if err := unmarshalText(reflect.ValueOf(&value).Elem(), text); err != nil {
+		return err
+	}
+	o.setValue(value)
+
+	return nil
+}
+
+// marshalText returns the text representation of the given addressable value.
+func marshalText(v reflect.Value) ([]byte, error) {
+	if v.Type().Implements(textMarshalerType) {
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			return []byte(EmptyText), nil
+		}
+		return v.Interface().(encoding.TextMarshaler).MarshalText()
+	}
+
+	if v.CanAddr() && v.Addr().Type().Implements(textMarshalerType) {
+		return v.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return []byte(EmptyText), nil
+		}
+		return marshalText(v.Elem())
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+
+	return nil, fmt.Errorf("%w: cannot marshal type %s", ErrUnsupportedText, v.Type())
+}
+
+// unmarshalText decodes text into the given addressable value.
+func unmarshalText(v reflect.Value, text []byte) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return unmarshalText(v.Elem(), text)
+	}
+
+	if v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText(text)
+	}
+
+	s := string(text)
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+		return nil
+	}
+
+	return fmt.Errorf("%w: cannot unmarshal into type %s", ErrUnsupportedText, v.Type())
+}
@@ -0,0 +1,236 @@
+package goptional
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type sampleLevel int
+
+func (l sampleLevel) MarshalText() ([]byte, error) {
+	return []byte("L" + strconv.Itoa(int(l))), nil
+}
+
+func (l *sampleLevel) UnmarshalText(text []byte) error {
+	i, err := strconv.Atoi(string(text[1:]))
+	*l = sampleLevel(i)
+	return err
+}
+
+func TestMarshalText_Empty(t *testing.T) {
+	text, err := Empty[int]().MarshalText()
+	require.NoError(t, err)
+	require.EqualValues(t, text, []byte(""))
+
+	var opt Optional[string]
+	text, err = opt.MarshalText()
+	require.NoError(t, err)
+	require.EqualValues(t, text, []byte(""))
+}
+
+func TestMarshalText_Primitives(t *testing.T) {
+	cases := []struct {
+		marshal func() ([]byte, error)
+		text    string
+	}{
+		{Of("gm").MarshalText, "gm"},
+		{Of(true).MarshalText, "true"},
+		{Of(-123).MarshalText, "-123"},
+		{Of[int8](-8).MarshalText, "-8"},
+		{Of[uint64](64).MarshalText, "64"},
+		{Of(1.5).MarshalText, "1.5"},
+		{Of[float32](0.1).MarshalText, "0.1"},
+	}
+
+	for _, c := range cases {
+		text, err := c.marshal()
+		require.NoError(t, err)
+		require.EqualValues(t, string(text), c.text)
+	}
+}
+
+func TestMarshalText_Delegates(t *testing.T) {
+	text, err := Of(sampleLevel(3)).MarshalText()
+	require.NoError(t, err)
+	require.EqualValues(t, string(text), "L3")
+
+	text, err = Of(net.ParseIP("127.0.0.1")).MarshalText()
+	require.NoError(t, err)
+	require.EqualValues(t, string(text), "127.0.0.1")
+
+	n := 123
+	text, err = Of(&n).MarshalText()
+	require.NoError(t, err)
+	require.EqualValues(t, string(text), "123")
+}
+
+func TestMarshalText_Unsupported(t *testing.T) {
+	_, err := Of(sampleStruct{}).MarshalText()
+	require.ErrorIs(t, err, ErrUnsupportedText)
+
+	_, err = Of([]int{1}).MarshalText()
+	require.ErrorIs(t, err, ErrUnsupportedText)
+}
+
+func TestUnmarshalText_Nil(t *testing.T) {
+	var opt *Optional[int]
+	require.ErrorIs(t, opt.UnmarshalText([]byte("123")), ErrMutationOnNil)
+}
+
+func TestUnmarshalText_EmptyOnNotEmpty(t *testing.T) {
+	opt := Of(123)
+	require.NoError(t, opt.UnmarshalText(nil))
+	require.True(t, opt.IsEmpty())
+}
+
+func TestUnmarshalText_Primitives(t *testing.T) {
+	optStr := Empty[string]()
+	require.NoError(t, optStr.UnmarshalText([]byte("gm")))
+	require.EqualValues(t, optStr.Unwrap(), "gm")
+
+	optBool := Empty[bool]()
+	require.NoError(t, optBool.UnmarshalText([]byte("false")))
+	require.False(t, optBool.Unwrap())
+
+	optInt := Empty[int16]()
+	require.NoError(t, optInt.UnmarshalText([]byte("-16")))
+	require.EqualValues(t, optInt.Unwrap(), -16)
+
+	optUint := Empty[uint]()
+	require.NoError(t, optUint.UnmarshalText([]byte("7")))
+	require.EqualValues(t, optUint.Unwrap(), 7)
+
+	optFloat := Empty[float64]()
+	require.NoError(t, optFloat.UnmarshalText([]byte("1.5")))
+	require.EqualValues(t, optFloat.Unwrap(), 1.5)
+
+	optPtr := Empty[*int]()
+	require.NoError(t, optPtr.UnmarshalText([]byte("123")))
+	require.EqualValues(t, *optPtr.Unwrap(), 123)
+}
+
+func TestUnmarshalText_Delegates(t *testing.T) {
+	optLevel := Empty[sampleLevel]()
+	require.NoError(t, optLevel.UnmarshalText([]byte("L3")))
+	require.EqualValues(t, optLevel.Unwrap(), 3)
+
+	optTime := Empty[time.Time]()
+	require.NoError(t, optTime.UnmarshalText([]byte("2022-12-01T10:00:00Z")))
+	require.EqualValues(t, optTime.Unwrap(), time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC))
+}
+
+func TestUnmarshalText_InvalidData(t *testing.T) {
+	opt := Of(123)
+	require.Error(t, opt.UnmarshalText([]byte("gm")))
+	require.EqualValues(t, opt.Unwrap(), 123)
+
+	optUint := Empty[uint8]()
+	require.Error(t, optUint.UnmarshalText([]byte("256")))
+	require.True(t, optUint.IsEmpty())
+
+	optStruct := Empty[sampleStruct]()
+	require.ErrorIs(t, optStruct.UnmarshalText([]byte("gm")), ErrUnsupportedText)
+}
+
+type sampleDash struct{}
+
+func (sampleDash) EmptyText() string {
+	return "-"
+}
+
+func TestTextOptional(t *testing.T) {
+	var opt TextOptional[string, sampleDash]
+
+	text, err := opt.MarshalText()
+	require.NoError(t, err)
+	require.EqualValues(t, string(text), "-")
+
+	require.NoError(t, opt.UnmarshalText([]byte("")))
+	require.True(t, opt.IsPresent())
+	require.EqualValues(t, opt.Unwrap(), "")
+
+	text, err = opt.MarshalText()
+	require.NoError(t, err)
+	require.EqualValues(t, string(text), "")
+
+	require.NoError(t, opt.UnmarshalText([]byte("-")))
+	require.True(t, opt.IsEmpty())
+
+	// The package-wide representation is left untouched.
+	text, err = Empty[string]().MarshalText()
+	require.NoError(t, err)
+	require.EqualValues(t, string(text), EmptyText)
+
+	var nilOpt *TextOptional[string, sampleDash]
+	text, err = nilOpt.MarshalText()
+	require.NoError(t, err)
+	require.EqualValues(t, string(text), "-")
+	require.ErrorIs(t, nilOpt.UnmarshalText([]byte("gm")), ErrMutationOnNil)
+}
+
+func TestTextOptional_Flag(t *testing.T) {
+	fs := flag.NewFlagSet("goptional", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	var level TextOptional[int, sampleDash]
+	fs.TextVar(&level, "level", &TextOptional[int, sampleDash]{}, "")
+
+	require.NoError(t, fs.Parse([]string{"-level", "3"}))
+	require.EqualValues(t, level.Unwrap(), 3)
+
+	require.NoError(t, fs.Parse([]string{"-level", "-"}))
+	require.True(t, level.IsEmpty())
+}
+
+func TestMapKey_JSON(t *testing.T) {
+	m := map[MapKey[int]]string{
+		KeyOf(1):        "one",
+		MapKey[int]{}:   "none",
+		KeyOf[int](-12): "minus twelve",
+	}
+
+	jsonBytes, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"1":"one","":"none","-12":"minus twelve"}`, string(jsonBytes))
+
+	var m2 map[MapKey[int]]string
+	require.NoError(t, json.Unmarshal(jsonBytes, &m2))
+	require.EqualValues(t, m, m2)
+	require.EqualValues(t, m2[KeyOf(1)], "one")
+
+	k := KeyOf[*int](nil)
+	require.True(t, k.IsEmpty())
+}
+
+func TestText_JSONMapKeys(t *testing.T) {
+	var m map[Optional[int]]string
+	require.NoError(t, json.Unmarshal([]byte(`{"1":"one","":"none"}`), &m))
+	require.Len(t, m, 2)
+	require.EqualValues(t, m[*Of(1)], "one")
+	require.EqualValues(t, m[Optional[int]{}], "none")
+}
+
+func TestText_Flag(t *testing.T) {
+	fs := flag.NewFlagSet("goptional", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	var port, host Optional[string]
+	var timeout Optional[int]
+	fs.TextVar(&port, "port", Empty[string](), "")
+	fs.TextVar(&host, "host", Of("localhost"), "")
+	fs.TextVar(&timeout, "timeout", Empty[int](), "")
+
+	require.NoError(t, fs.Parse([]string{"-port", "8080"}))
+	require.EqualValues(t, port.Unwrap(), "8080")
+	require.EqualValues(t, host.Unwrap(), "localhost")
+	require.True(t, timeout.IsEmpty())
+
+	require.Error(t, fs.Parse([]string{"-timeout", "gm"}))
+}
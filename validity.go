@@ -0,0 +1,114 @@
+package goptional
+
+import (
+	"reflect"
+	"sync/atomic"
+	"unsafe"
+)
+
+// isValueValid returns false if the given value is either nil or invalid.
+//
+// Values of common basic types can never be nil and are recognized through a type switch.
+// For any other type, how its values can be nil is decided once and cached (see nilabilityOf),
+// so that only values of interface types go through reflection.
+func isValueValid[T any](value T) bool {
+	switch interface{}(value).(type) {
+	case bool, string,
+		int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64, uintptr,
+		float32, float64, complex64, complex128:
+		return true
+	}
+
+	switch nilabilityOf[T]() {
+	case neverNil:
+		return true
+	case nilWord:
+		// Pointers, maps, channels and functions are a single word, and a slice starts with its data pointer:
+		// either is nil if and only if such word is.
+		return *(*unsafe.Pointer)(unsafe.Pointer(&value)) != nil
+	default:
+		return isNillableValueValid(value)
+	}
+}
+
+// isNillableValueValid returns false if the given value is either nil or invalid, relying on reflection.
+// Since value is boxed into an interface, reflection sees its dynamic type: typed nils held by
+// interface-typed values are therefore reported as nil.
+func isNillableValueValid[T any](value T) bool {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		return false
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+		return !v.IsNil()
+	default:
+		return true
+	}
+}
+
+// nilability tells whether, and how, values of a given type can be nil.
+type nilability uint8
+
+const (
+	// neverNil types, e.g. numbers, strings, structs and arrays, have no nil value.
+	neverNil nilability = iota + 1
+	// nilWord types, i.e. pointers, slices, maps, channels and functions, are nil if their first word is.
+	nilWord
+	// nilInterface types may hold a typed nil, which only reflection can tell.
+	nilInterface
+)
+
+// nilabilityCacheSize is the number of slots of nilabilityCache.
+const nilabilityCacheSize = 256
+
+// nilabilityCache holds the nilability of each type seen so far.
+// It is a fixed-size, lock-free table indexed by the address of the type descriptor:
+// two types sharing a slot merely evict each other, and their nilability is then computed again.
+var nilabilityCache [nilabilityCacheSize]atomic.Pointer[nilabilityEntry]
+
+type nilabilityEntry struct {
+	typ        unsafe.Pointer
+	nilability nilability
+}
+
+// nilabilityOf returns the nilability of type T.
+// It is computed through reflection on the first call for a given T, and cached afterwards.
+func nilabilityOf[T any]() nilability {
+	return nilabilityOfElem((*T)(nil))
+}
+
+// nilabilityOfElem returns the nilability of the type pointed to by ptr, a nil pointer boxed into an interface.
+// Unlike nilabilityOf, it is not generic, so that looking up the cache needs no dictionary.
+func nilabilityOfElem(ptr interface{}) nilability {
+	typ := typeID(ptr)
+	slot := &nilabilityCache[(uintptr(typ)>>4)%nilabilityCacheSize]
+	if e := slot.Load(); e != nil && e.typ == typ {
+		return e.nilability
+	}
+
+	e := &nilabilityEntry{typ: typ, nilability: nilabilityOfKind(reflect.TypeOf(ptr).Elem().Kind())}
+	slot.Store(e)
+
+	return e.nilability
+}
+
+// nilabilityOfKind returns the nilability of types of the given kind.
+func nilabilityOfKind(kind reflect.Kind) nilability {
+	switch kind {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+		return nilWord
+	case reflect.Interface:
+		return nilInterface
+	default:
+		return neverNil
+	}
+}
+
+// typeID returns a pointer that uniquely identifies the dynamic type of the given interface, without allocating:
+// its type descriptor.
+func typeID(boxed interface{}) unsafe.Pointer {
+	return (*[2]unsafe.Pointer)(unsafe.Pointer(&boxed))[0]
+}
@@ -0,0 +1,446 @@
+package goptional
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type validityStruct struct {
+	A int
+}
+
+type validityNamedInt int
+
+type validityLargeStruct struct {
+	A, B, C, D, E, F, G, H int
+}
+
+func TestIsValueValid(t *testing.T) {
+	var (
+		nilPtr   *int
+		nilIface interface{}
+		nilErr   error
+		nilSlice []int
+		nilMap   map[string]int
+		nilChan  chan int
+		nilFunc  func()
+	)
+
+	require.True(t, isValueValid(0))
+	require.True(t, isValueValid(""))
+	require.True(t, isValueValid(false))
+	require.True(t, isValueValid(0.0))
+	require.True(t, isValueValid(validityNamedInt(0)))
+	require.True(t, isValueValid(validityStruct{}))
+	require.True(t, isValueValid([2]int{}))
+
+	require.False(t, isValueValid(nilPtr))
+	require.False(t, isValueValid(nilIface))
+	require.False(t, isValueValid(nilErr))
+	require.False(t, isValueValid(nilSlice))
+	require.False(t, isValueValid(nilMap))
+	require.False(t, isValueValid(nilChan))
+	require.False(t, isValueValid(nilFunc))
+
+	x := 1
+	require.True(t, isValueValid(&x))
+	require.True(t, isValueValid[interface{}](1))
+	require.True(t, isValueValid[interface{}](""))
+	require.False(t, isValueValid[interface{}](nilPtr))
+	require.True(t, isValueValid([]int{}))
+	require.True(t, isValueValid(map[string]int{}))
+	require.True(t, isValueValid(make(chan int)))
+	require.True(t, isValueValid(func() {}))
+}
+
+func TestNilabilityOf(t *testing.T) {
+	// Twice each, so that the cached nilability is checked as well.
+	for i := 0; i < 2; i++ {
+		require.Equal(t, neverNil, nilabilityOf[int]())
+		require.Equal(t, neverNil, nilabilityOf[validityNamedInt]())
+		require.Equal(t, neverNil, nilabilityOf[validityStruct]())
+		require.Equal(t, neverNil, nilabilityOf[validityLargeStruct]())
+		require.Equal(t, neverNil, nilabilityOf[[2]int]())
+		require.Equal(t, neverNil, nilabilityOf[time.Time]())
+
+		require.Equal(t, nilWord, nilabilityOf[*int]())
+		require.Equal(t, nilWord, nilabilityOf[[]int]())
+		require.Equal(t, nilWord, nilabilityOf[map[string]int]())
+		require.Equal(t, nilWord, nilabilityOf[chan int]())
+		require.Equal(t, nilWord, nilabilityOf[func()]())
+		require.Equal(t, nilWord, nilabilityOf[validityFunc]())
+
+		require.Equal(t, nilInterface, nilabilityOf[interface{}]())
+		require.Equal(t, nilInterface, nilabilityOf[error]())
+		require.Equal(t, nilInterface, nilabilityOf[validityStringer]())
+	}
+}
+
+func TestNilabilityOf_SharedSlot(t *testing.T) {
+	// Evict the cached nilability of int by storing that of another type in its slot.
+	typ := typeID((*int)(nil))
+	slot := &nilabilityCache[(uintptr(typ)>>4)%nilabilityCacheSize]
+	slot.Store(&nilabilityEntry{typ: typeID((**int)(nil)), nilability: nilWord})
+
+	require.Equal(t, neverNil, nilabilityOf[int]())
+	require.Equal(t, typ, slot.Load().typ)
+}
+
+func TestNilabilityOf_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				require.Equal(t, neverNil, nilabilityOf[validityStruct]())
+				require.Equal(t, nilWord, nilabilityOf[validitySlice]())
+				require.Equal(t, nilInterface, nilabilityOf[error]())
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestIsValueValid_EmptyButNotNil(t *testing.T) {
+	require.True(t, isValueValid([]int{}))
+	require.True(t, isValueValid(make([]int, 0)))
+	require.True(t, isValueValid(([]int{1, 2})[2:]))
+	require.True(t, isValueValid(validitySlice{}))
+	require.True(t, isValueValid(validityMap{}))
+	require.True(t, isValueValid(validityFunc(func() string { return "" })))
+	require.True(t, isValueValid(new(struct{})))
+
+	var nilSlice validitySlice
+	var nilMap validityMap
+	require.False(t, isValueValid(nilSlice))
+	require.False(t, isValueValid(nilMap))
+}
+
+// The Reflect variants measure the previous, reflection-only validity check
+// and serve as a baseline for the current one.
+
+// ofReflect mirrors Of with the previous validity check.
+func ofReflect[T any](value T) *Optional[T] {
+	if isNillableValueValid(value) {
+		return &Optional[T]{value: value, isValueValid: true}
+	}
+
+	return Empty[T]()
+}
+
+// mapReflect mirrors Map with the previous validity check.
+func mapReflect[X, Y any](input *Optional[X], mapper func(X) Y) *Optional[Y] {
+	if input.IsEmpty() || mapper == nil {
+		return Empty[Y]()
+	}
+
+	return ofReflect(mapper(input.Unwrap()))
+}
+
+func BenchmarkIsValueValid_Int(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isValueValid(i) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkIsValueValid_IntReflect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isNillableValueValid(i) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkIsValueValid_Struct(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isValueValid(validityStruct{A: i}) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkIsValueValid_StructReflect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isNillableValueValid(validityStruct{A: i}) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkIsValueValid_Ptr(b *testing.B) {
+	x := 1
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isValueValid(&x) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkIsValueValid_PtrReflect(b *testing.B) {
+	x := 1
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isNillableValueValid(&x) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkIsValueValid_NamedInt(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isValueValid(validityNamedInt(i)) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkIsValueValid_NamedIntReflect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isNillableValueValid(validityNamedInt(i)) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkIsValueValid_LargeStruct(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isValueValid(validityLargeStruct{A: i}) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkIsValueValid_LargeStructReflect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isNillableValueValid(validityLargeStruct{A: i}) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkIsValueValid_Array(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isValueValid([4]int{i}) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkIsValueValid_ArrayReflect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if isNillableValueValid([4]int{i}) {
+			benchSink++
+		}
+	}
+}
+
+func BenchmarkOf_Int(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += Of(i).OrElse(-1)
+	}
+}
+
+func BenchmarkOf_IntReflect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += ofReflect(i).OrElse(-1)
+	}
+}
+
+func BenchmarkOf_String(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += len(Of("goptional").OrElse(""))
+	}
+}
+
+func BenchmarkOf_StringReflect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += len(ofReflect("goptional").OrElse(""))
+	}
+}
+
+func BenchmarkOf_Struct(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += Of(validityStruct{A: i}).OrElse(validityStruct{}).A
+	}
+}
+
+func BenchmarkOf_StructReflect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += ofReflect(validityStruct{A: i}).OrElse(validityStruct{}).A
+	}
+}
+
+func BenchmarkOf_LargeStruct(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += Of(validityLargeStruct{A: i}).OrElse(validityLargeStruct{}).A
+	}
+}
+
+func BenchmarkOf_LargeStructReflect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += ofReflect(validityLargeStruct{A: i}).OrElse(validityLargeStruct{}).A
+	}
+}
+
+func BenchmarkOf_Ptr(b *testing.B) {
+	x := 1
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += *Of(&x).OrElse(&x)
+	}
+}
+
+func BenchmarkOf_PtrReflect(b *testing.B) {
+	x := 1
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += *ofReflect(&x).OrElse(&x)
+	}
+}
+
+func BenchmarkMap_Int(b *testing.B) {
+	opt := Of(1)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += Map(opt, func(x int) int { return x + i }).OrElse(-1)
+	}
+}
+
+func BenchmarkMap_IntReflect(b *testing.B) {
+	opt := ofReflect(1)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += mapReflect(opt, func(x int) int { return x + i }).OrElse(-1)
+	}
+}
+
+func BenchmarkFilter_Int(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += Of(i).Filter(func(x int) bool { return x%2 == 0 }).OrElse(-1)
+	}
+}
+
+func BenchmarkFilter_IntReflect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink += ofReflect(i).Filter(func(x int) bool { return x%2 == 0 }).OrElse(-1)
+	}
+}
+
+type validityErr struct{}
+
+func (*validityErr) Error() string {
+	return "validity"
+}
+
+type validityStringer interface {
+	String() string
+}
+
+type validityFunc func() string
+
+func (f validityFunc) String() string {
+	return "validity"
+}
+
+type validityMap map[string]int
+
+func (m validityMap) String() string {
+	return "validity"
+}
+
+type validitySlice []int
+
+func (s validitySlice) String() string {
+	return "validity"
+}
+
+type validityChan chan int
+
+func (c validityChan) String() string {
+	return "validity"
+}
+
+func TestOf_TypedNilInInterface(t *testing.T) {
+	var (
+		nilErrPtr *validityErr
+		nilFunc   validityFunc
+		nilMap    validityMap
+		nilSlice  validitySlice
+		nilChan   validityChan
+	)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		present bool
+	}{
+		{"pointer", nilErrPtr, false},
+		{"map", nilMap, false},
+		{"slice", nilSlice, false},
+		{"chan", nilChan, false},
+		{"func", nilFunc, false},
+		{"non-nil pointer", &validityErr{}, true},
+		{"non-nil map", validityMap{}, true},
+		{"non-nil slice", validitySlice{}, true},
+		{"non-nil chan", make(validityChan), true},
+		{"non-nil func", validityFunc(func() string { return "" }), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.present, Of(tt.value).IsPresent())
+			require.Equal(t, tt.present, OptionOf(tt.value).IsPresent())
+			require.Equal(t, tt.present, FieldOf(tt.value).IsPresent())
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		var err error = nilErrPtr
+		require.True(t, Of(err).IsEmpty())
+
+		err = &validityErr{}
+		require.True(t, Of(err).IsPresent())
+	})
+
+	t.Run("named interface", func(t *testing.T) {
+		for _, s := range []validityStringer{nilFunc, nilMap, nilSlice, nilChan} {
+			require.True(t, Of(s).IsEmpty())
+		}
+
+		require.True(t, Of[validityStringer](validityMap{}).IsPresent())
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		opt := Of[error](&validityErr{})
+		_, err := opt.Replace(nilErrPtr)
+		require.NoError(t, err)
+		require.True(t, opt.IsEmpty())
+	})
+}
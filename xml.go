@@ -0,0 +1,181 @@
+package goptional
+
+import (
+	"encoding/xml"
+	"reflect"
+)
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+var xmlMarshalerAttrType = reflect.TypeOf((*xml.MarshalerAttr)(nil)).Elem()
+var xmlUnmarshalerAttrType = reflect.TypeOf((*xml.UnmarshalerAttr)(nil)).Elem()
+
+// MarshalXML implements the xml.Marshaler interface.
+// A present value is encoded exactly like T would be, while an empty Optional is omitted.
+// Use NillableXML to encode it as xsi:nil instead.
+func (o *Optional[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if o.IsPresent() {
+		return e.EncodeElement(o.Unwrap(), start)
+	}
+
+	return nil
+}
+
+// NillableXML is an Optional that, when empty, is encoded as an empty XML element
+// carrying xsi:nil="true" rather than omitted.
+// All other methods are those of the embedded Optional.
+type NillableXML[T any] struct {
+	Optional[T]
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// A present value is encoded exactly like T would be, while an empty NillableXML
+// is encoded as an empty element carrying xsi:nil="true".
+func (o *NillableXML[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if o != nil && o.IsPresent() {
+		return e.EncodeElement(o.Unwrap(), start)
+	}
+
+	start.Attr = append(start.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+	)
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+// An element carrying xsi:nil="true" leaves this instance empty,
+// while any other element is decoded into a value of type T.
+//
+// It returns an ErrMutationOnNil error if this instance is nil.
+func (o *Optional[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if o == nil {
+		return ErrMutationOnNil
+	}
+
+	if isXMLNil(start) {
+		o.unsetValue()
+		return d.Skip()
+	}
+
+	var value T
+	if current := reflect.ValueOf(&o.value).Elem(); o.IsPresent() && current.Kind() == reflect.Slice {
+		// Repeated elements accumulate into a slice, as with a plain []T field.
+		// Capping its capacity makes appending copy the elements rather than write into
+		// a backing array the caller may share.
+		// Any other value, e.g. a pointer, is decoded from scratch, leaving what the caller holds untouched.
+		reflect.ValueOf(&value).Elem().Set(current.Slice3(0, current.Len(), current.Len()))
+	}
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	o.setValue(value)
+
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+// An empty Optional omits the attribute, while a present value is encoded
+// through its own xml.MarshalerAttr, if any, or following the rules of MarshalText otherwise.
+func (o *Optional[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return o.marshalXMLAttr(name, EmptyText)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+// An attribute whose value equals EmptyText leaves this instance empty, as UnmarshalText would,
+// while any other attribute is decoded through the xml.UnmarshalerAttr of T, if any,
+// or following the rules of UnmarshalText otherwise.
+//
+// It returns an ErrMutationOnNil error if this instance is nil.
+func (o *Optional[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return o.unmarshalXMLAttr(attr, EmptyText)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface, as Optional.MarshalXMLAttr does,
+// encoding an empty instance as an attribute holding the EmptyText of S, unless the latter is empty.
+func (o *TextOptional[T, S]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if o == nil {
+		return Empty[T]().marshalXMLAttr(name, emptyTextOf[S]())
+	}
+
+	return o.Optional.marshalXMLAttr(name, emptyTextOf[S]())
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface, as Optional.UnmarshalXMLAttr does,
+// leaving this instance empty if the attribute value equals the EmptyText of S.
+//
+// It returns an ErrMutationOnNil error if this instance is nil.
+func (o *TextOptional[T, S]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if o == nil {
+		return ErrMutationOnNil
+	}
+
+	return o.Optional.unmarshalXMLAttr(attr, emptyTextOf[S]())
+}
+
+// marshalXMLAttr encodes this instance as an attribute, holding the given text if it is empty.
+// An empty instance omits the attribute if such text is empty as well.
+func (o *Optional[T]) marshalXMLAttr(name xml.Name, empty string) (xml.Attr, error) {
+	if o.IsEmpty() {
+		if empty == "" {
+			return xml.Attr{}, nil
+		}
+		return xml.Attr{Name: name, Value: empty}, nil
+	}
+
+	v := reflect.ValueOf(&o.value).Elem()
+	if v.Type().Implements(xmlMarshalerAttrType) {
+		return v.Interface().(xml.MarshalerAttr).MarshalXMLAttr(name)
+	}
+
+	if v.Addr().Type().Implements(xmlMarshalerAttrType) {
+		return v.Addr().Interface().(xml.MarshalerAttr).MarshalXMLAttr(name)
+	}
+
+	text, err := marshalText(v)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// unmarshalXMLAttr decodes the given attribute into this instance, leaving it empty if its value equals the given text.
+func (o *Optional[T]) unmarshalXMLAttr(attr xml.Attr, empty string) error {
+	if o == nil {
+		return ErrMutationOnNil
+	}
+
+	if attr.Value == empty {
+		o.unsetValue()
+		return nil
+	}
+
+	var value T
+	v := reflect.ValueOf(&value).Elem()
+	if v.Addr().Type().Implements(xmlUnmarshalerAttrType) {
+		if err := v.Addr().Interface().(xml.UnmarshalerAttr).UnmarshalXMLAttr(attr); err != nil {
+			return err
+		}
+	} else if err := unmarshalText(v, []byte(attr.Value)); err != nil {
+		return err
+	}
+	o.setValue(value)
+
+	return nil
+}
+
+// isXMLNil returns true if the given element carries xsi:nil="true".
+func isXMLNil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") {
+			return attr.Value == "true" || attr.Value == "1"
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,236 @@
+package goptional
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type sampleXMLPoint struct {
+	X int `xml:"x"`
+	Y int `xml:"y"`
+}
+
+type sampleXMLDoc struct {
+	XMLName xml.Name                  `xml:"doc"`
+	ID      Optional[int]             `xml:"id,attr"`
+	Lang    Optional[string]          `xml:"lang,attr"`
+	Name    Optional[string]          `xml:"name"`
+	Point   Optional[*sampleXMLPoint] `xml:"point"`
+	Tags    Optional[[]string]        `xml:"tag"`
+}
+
+type sampleXMLAttr string
+
+func (a sampleXMLAttr) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: strings.ToUpper(string(a))}, nil
+}
+
+func (a *sampleXMLAttr) UnmarshalXMLAttr(attr xml.Attr) error {
+	*a = sampleXMLAttr(strings.ToLower(attr.Value))
+	return nil
+}
+
+func TestMarshalXML_Empty(t *testing.T) {
+	xmlBytes, err := xml.Marshal(&sampleXMLDoc{})
+	require.NoError(t, err)
+	require.EqualValues(t, string(xmlBytes), `<doc></doc>`)
+}
+
+type sampleXMLNilDoc struct {
+	XMLName xml.Name                     `xml:"doc"`
+	Lang    Optional[string]             `xml:"lang,attr"`
+	Name    NillableXML[string]          `xml:"name"`
+	Point   NillableXML[*sampleXMLPoint] `xml:"point"`
+	Note    Optional[string]             `xml:"note"`
+}
+
+func TestMarshalXML_EmptyAsNil(t *testing.T) {
+	xmlBytes, err := xml.Marshal(&sampleXMLNilDoc{Lang: *Of("en")})
+	require.NoError(t, err)
+	require.EqualValues(t, string(xmlBytes),
+		`<doc lang="en">`+
+			`<name xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></name>`+
+			`<point xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></point>`+
+			`</doc>`)
+
+	xmlBytes, err = xml.Marshal(&sampleXMLNilDoc{
+		Name: NillableXML[string]{*Of("gm")},
+		Note: *Of("n"),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, string(xmlBytes),
+		`<doc><name>gm</name>`+
+			`<point xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></point>`+
+			`<note>n</note></doc>`)
+}
+
+func TestNillableXML_RoundTrip(t *testing.T) {
+	doc := sampleXMLNilDoc{Point: NillableXML[*sampleXMLPoint]{*Of(&sampleXMLPoint{X: 1, Y: 2})}}
+	xmlBytes, err := xml.Marshal(&doc)
+	require.NoError(t, err)
+
+	var decoded sampleXMLNilDoc
+	require.NoError(t, xml.Unmarshal(xmlBytes, &decoded))
+	require.True(t, decoded.Name.IsEmpty())
+	require.EqualValues(t, decoded.Point.Unwrap(), &sampleXMLPoint{X: 1, Y: 2})
+	require.True(t, decoded.Note.IsEmpty())
+}
+
+func TestMarshalXML_NotEmpty(t *testing.T) {
+	doc := &sampleXMLDoc{
+		ID:    *Of(123),
+		Name:  *Of("gm"),
+		Point: *Of(&sampleXMLPoint{X: 1, Y: 2}),
+		Tags:  *Of([]string{"a", "b"}),
+	}
+
+	xmlBytes, err := xml.Marshal(doc)
+	require.NoError(t, err)
+	require.EqualValues(t, string(xmlBytes),
+		`<doc id="123"><name>gm</name><point><x>1</x><y>2</y></point><tag>a</tag><tag>b</tag></doc>`)
+}
+
+func TestMarshalXML_MatchesPlainType(t *testing.T) {
+	type plain struct {
+		XMLName xml.Name        `xml:"p"`
+		Point   *sampleXMLPoint `xml:"point"`
+	}
+	type optional struct {
+		XMLName xml.Name                  `xml:"p"`
+		Point   Optional[*sampleXMLPoint] `xml:"point"`
+	}
+
+	point := &sampleXMLPoint{X: 3, Y: 4}
+	plainBytes, err := xml.Marshal(&plain{Point: point})
+	require.NoError(t, err)
+	optBytes, err := xml.Marshal(&optional{Point: *Of(point)})
+	require.NoError(t, err)
+	require.EqualValues(t, optBytes, plainBytes)
+}
+
+func TestUnmarshalXML_Nil(t *testing.T) {
+	var opt *Optional[int]
+	err := opt.UnmarshalXML(xml.NewDecoder(strings.NewReader("")), xml.StartElement{})
+	require.ErrorIs(t, err, ErrMutationOnNil)
+	require.ErrorIs(t, opt.UnmarshalXMLAttr(xml.Attr{}), ErrMutationOnNil)
+}
+
+func TestUnmarshalXML_Missing(t *testing.T) {
+	var doc sampleXMLDoc
+	require.NoError(t, xml.Unmarshal([]byte(`<doc></doc>`), &doc))
+	require.True(t, doc.ID.IsEmpty())
+	require.True(t, doc.Lang.IsEmpty())
+	require.True(t, doc.Name.IsEmpty())
+	require.True(t, doc.Point.IsEmpty())
+	require.True(t, doc.Tags.IsEmpty())
+}
+
+func TestUnmarshalXML_XSINil(t *testing.T) {
+	doc := sampleXMLDoc{Name: *Of("stale")}
+	data := `<doc xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+		`<name xsi:nil="true"/><point xsi:nil="1"><x>1</x></point></doc>`
+
+	require.NoError(t, xml.Unmarshal([]byte(data), &doc))
+	require.True(t, doc.Name.IsEmpty())
+	require.True(t, doc.Point.IsEmpty())
+}
+
+func TestUnmarshalXML_NotEmpty(t *testing.T) {
+	var doc sampleXMLDoc
+	data := `<doc id="123" lang="en"><name>gm</name><point><x>1</x><y>2</y></point><tag>a</tag><tag>b</tag></doc>`
+
+	require.NoError(t, xml.Unmarshal([]byte(data), &doc))
+	require.EqualValues(t, doc.ID.Unwrap(), 123)
+	require.EqualValues(t, doc.Lang.Unwrap(), "en")
+	require.EqualValues(t, doc.Name.Unwrap(), "gm")
+	require.EqualValues(t, doc.Point.Unwrap(), &sampleXMLPoint{X: 1, Y: 2})
+	require.EqualValues(t, doc.Tags.Unwrap(), []string{"a", "b"})
+}
+
+func TestUnmarshalXML_InvalidData(t *testing.T) {
+	var doc sampleXMLDoc
+	require.Error(t, xml.Unmarshal([]byte(`<doc id="gm"></doc>`), &doc))
+	require.True(t, doc.ID.IsEmpty())
+
+	type ints struct {
+		N Optional[int] `xml:"n"`
+	}
+	var i ints
+	require.Error(t, xml.Unmarshal([]byte(`<ints><n>gm</n></ints>`), &i))
+	require.True(t, i.N.IsEmpty())
+}
+
+func TestXMLAttr_Delegates(t *testing.T) {
+	attr, err := Of(sampleXMLAttr("gm")).MarshalXMLAttr(xml.Name{Local: "a"})
+	require.NoError(t, err)
+	require.EqualValues(t, attr, xml.Attr{Name: xml.Name{Local: "a"}, Value: "GM"})
+
+	opt := Empty[sampleXMLAttr]()
+	require.NoError(t, opt.UnmarshalXMLAttr(attr))
+	require.EqualValues(t, opt.Unwrap(), "gm")
+}
+
+func TestUnmarshalXMLAttr_EmptyText(t *testing.T) {
+	opt := Of(123)
+	require.NoError(t, opt.UnmarshalXMLAttr(xml.Attr{Name: xml.Name{Local: "id"}, Value: EmptyText}))
+	require.True(t, opt.IsEmpty())
+
+	doc := sampleXMLDoc{ID: *Of(1)}
+	require.NoError(t, xml.Unmarshal([]byte(`<doc id=""></doc>`), &doc))
+	require.True(t, doc.ID.IsEmpty())
+}
+
+func TestXMLAttr_Empty(t *testing.T) {
+	attr, err := Empty[int]().MarshalXMLAttr(xml.Name{Local: "a"})
+	require.NoError(t, err)
+	require.EqualValues(t, attr, xml.Attr{})
+
+	_, err = Of(sampleStruct{}).MarshalXMLAttr(xml.Name{Local: "a"})
+	require.ErrorIs(t, err, ErrUnsupportedText)
+}
+
+func TestTextOptional_XMLAttr(t *testing.T) {
+	type doc struct {
+		XMLName xml.Name                         `xml:"doc"`
+		Level   TextOptional[int, sampleDash]    `xml:"level,attr"`
+		Name    TextOptional[string, sampleDash] `xml:"name,attr"`
+	}
+
+	xmlBytes, err := xml.Marshal(&doc{Name: TextOptional[string, sampleDash]{*Of("")}})
+	require.NoError(t, err)
+	require.EqualValues(t, string(xmlBytes), `<doc level="-" name=""></doc>`)
+
+	d := doc{Level: TextOptional[int, sampleDash]{*Of(1)}}
+	require.NoError(t, xml.Unmarshal([]byte(`<doc level="-" name=""></doc>`), &d))
+	require.True(t, d.Level.IsEmpty())
+	require.EqualValues(t, d.Name.Unwrap(), "")
+
+	require.NoError(t, xml.Unmarshal([]byte(`<doc level="3"></doc>`), &d))
+	require.EqualValues(t, d.Level.Unwrap(), 3)
+
+	var nilOpt *TextOptional[int, sampleDash]
+	attr, err := nilOpt.MarshalXMLAttr(xml.Name{Local: "a"})
+	require.NoError(t, err)
+	require.EqualValues(t, attr, xml.Attr{Name: xml.Name{Local: "a"}, Value: "-"})
+	require.ErrorIs(t, nilOpt.UnmarshalXMLAttr(attr), ErrMutationOnNil)
+}
+
+func TestUnmarshalXML_DoesNotMutateCallerValue(t *testing.T) {
+	point := &sampleXMLPoint{X: 1, Y: 2}
+	doc := sampleXMLDoc{Point: *Of(point)}
+
+	require.NoError(t, xml.Unmarshal([]byte(`<doc><point><x>3</x></point></doc>`), &doc))
+	require.EqualValues(t, point, &sampleXMLPoint{X: 1, Y: 2})
+	require.EqualValues(t, doc.Point.Unwrap(), &sampleXMLPoint{X: 3})
+
+	tags := make([]string, 1, 4)
+	tags[0] = "a"
+	doc = sampleXMLDoc{Tags: *Of(tags)}
+
+	require.NoError(t, xml.Unmarshal([]byte(`<doc><tag>b</tag></doc>`), &doc))
+	require.EqualValues(t, doc.Tags.Unwrap(), []string{"a", "b"})
+	require.EqualValues(t, tags[:2], []string{"a", ""})
+}
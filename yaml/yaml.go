@@ -0,0 +1,257 @@
+// Package yaml provides YAML support for goptional through gopkg.in/yaml.v3.
+//
+// It lives in its own package to keep goptional free of third-party dependencies.
+package yaml
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/oleg-nykolyn/goptional"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// Optional wraps a goptional.Optional and implements yaml.Marshaler & yaml.Unmarshaler.
+//
+// It is meant to be used as a value field (not a pointer) of structs decoded from YAML:
+// `~`, `null` and a missing key all leave it empty, while any other node is decoded into T.
+// Note that yaml.v3 does not call unmarshalers on null nodes, so decoding null through yaml.Unmarshal
+// leaves an already populated instance untouched, exactly as it would with a plain struct.
+// Use Unmarshal or Decode to empty it instead.
+type Optional[T any] struct {
+	goptional.Optional[T]
+}
+
+// Error is returned when a YAML node cannot be decoded into the type held by an Optional.
+type Error struct {
+	// Line is the line of the offending node, starting at 1.
+	Line int
+	// Column is the column of the offending node, starting at 1.
+	Column int
+	// Err is the underlying decoding error.
+	Err error
+}
+
+// Error returns the string representation of this error.
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+// Unwrap returns the underlying decoding error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Empty returns a new empty Optional.
+func Empty[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// Of attempts to return a new non-empty Optional wrapping the given value.
+// If such value is either invalid or nil, it returns an empty Optional instead.
+func Of[T any](value T) Optional[T] {
+	return Optional[T]{*goptional.Of(value)}
+}
+
+// From returns a new Optional holding the value of o, if any, or an empty Optional otherwise.
+func From[T any](o *goptional.Optional[T]) Optional[T] {
+	if o.IsEmpty() {
+		return Empty[T]()
+	}
+
+	return Of(o.Unwrap())
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// An empty Optional is encoded as null, while a present value is encoded exactly like T would be.
+func (o Optional[T]) MarshalYAML() (interface{}, error) {
+	if o.IsEmpty() {
+		return nil, nil
+	}
+
+	return o.Unwrap(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+// A null node leaves this instance empty, while any other node is decoded into a value of type T.
+// As yaml.v3 never passes null nodes to unmarshalers, the former only happens through Unmarshal and Decode.
+//
+// It returns an *Error carrying the position of the node if decoding fails,
+// or goptional.ErrMutationOnNil if this instance is nil.
+func (o *Optional[T]) UnmarshalYAML(node *yamlv3.Node) error {
+	if o == nil {
+		return goptional.ErrMutationOnNil
+	}
+
+	if isNull(node) {
+		o.setNull()
+		return nil
+	}
+
+	var value T
+	if err := node.Decode(&value); err != nil {
+		return &Error{Line: node.Line, Column: node.Column, Err: err}
+	}
+	o.Optional = *goptional.Of(value)
+
+	return nil
+}
+
+// IsZero returns true if this instance is empty.
+// It allows yaml.v3 to omit empty fields tagged with omitempty.
+func (o Optional[T]) IsZero() bool {
+	return o.IsEmpty()
+}
+
+// setNull empties this instance.
+func (o *Optional[T]) setNull() {
+	o.Optional = *goptional.Empty[T]()
+}
+
+// nullable is implemented by every *Optional, whatever the type it holds.
+type nullable interface {
+	setNull()
+}
+
+var nullableType = reflect.TypeOf((*nullable)(nil)).Elem()
+
+// Unmarshal decodes the first document found within data into out, as yaml.Unmarshal does.
+// Unlike the latter, it also empties every Optional that the document sets to null,
+// e.g. one populated beforehand with a default value.
+func Unmarshal(data []byte, out interface{}) error {
+	var node yamlv3.Node
+	if err := yamlv3.Unmarshal(data, &node); err != nil {
+		return err
+	}
+
+	if node.Kind == 0 {
+		// No document at all: nothing to decode, as with yaml.Unmarshal.
+		return nil
+	}
+
+	return Decode(&node, out)
+}
+
+// Decode decodes the given node into out, as node.Decode does.
+// Unlike the latter, it also empties every Optional that the node sets to null,
+// e.g. one populated beforehand with a default value.
+// It suits nodes read through a yaml.Decoder, as well as the UnmarshalYAML methods of types holding Optionals.
+func Decode(node *yamlv3.Node, out interface{}) error {
+	err := node.Decode(out)
+	// yaml.v3 keeps decoding past type errors, so do nulls.
+	clearNulls(node, reflect.ValueOf(out))
+
+	return err
+}
+
+// clearNulls empties every Optional within v whose node is null, following the field names of yaml.v3.
+// Sequences are left alone: yaml.v3 decodes them into fresh elements, skipping null ones.
+func clearNulls(node *yamlv3.Node, v reflect.Value) {
+	for node.Kind == yamlv3.DocumentNode || node.Kind == yamlv3.AliasNode {
+		if node.Kind == yamlv3.AliasNode {
+			node = node.Alias
+		} else if len(node.Content) == 1 {
+			node = node.Content[0]
+		} else {
+			return
+		}
+	}
+
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	if isNull(node) {
+		if v.CanAddr() && v.Addr().Type().Implements(nullableType) {
+			v.Addr().Interface().(nullable).setNull()
+		}
+		return
+	}
+
+	switch {
+	case node.Kind == yamlv3.MappingNode && v.Kind() == reflect.Struct:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if field, ok := fieldByKey(v, node.Content[i].Value); ok {
+				clearNulls(node.Content[i+1], field)
+			}
+		}
+	case node.Kind == yamlv3.MappingNode && v.Kind() == reflect.Map:
+		clearMapNulls(node, v)
+	}
+}
+
+// clearMapNulls empties the Optionals held by map v whose node is null.
+// Map entries are not addressable, so they are replaced with empty Optionals instead.
+// Other values are left alone, as yaml.v3 decodes them into fresh entries.
+func clearMapNulls(node *yamlv3.Node, v reflect.Value) {
+	if v.IsNil() || !reflect.PtrTo(v.Type().Elem()).Implements(nullableType) {
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if !isNull(node.Content[i+1]) {
+			continue
+		}
+
+		key := reflect.New(v.Type().Key())
+		if err := node.Content[i].Decode(key.Interface()); err != nil {
+			continue
+		}
+		if v.MapIndex(key.Elem()).IsValid() {
+			v.SetMapIndex(key.Elem(), reflect.Zero(v.Type().Elem()))
+		}
+	}
+}
+
+// fieldByKey returns the field of struct v that yaml.v3 decodes the given key into, if any,
+// looking into inlined structs as well.
+func fieldByKey(v reflect.Value, key string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+
+		tag := field.Tag.Get("yaml")
+		if tag == "" && !strings.Contains(string(field.Tag), ":") {
+			tag = string(field.Tag)
+		}
+		if tag == "-" {
+			continue
+		}
+
+		name, flags, _ := strings.Cut(tag, ",")
+		if strings.Contains(","+flags+",", ",inline,") {
+			inlined := v.Field(i)
+			for inlined.Kind() == reflect.Ptr && !inlined.IsNil() {
+				inlined = inlined.Elem()
+			}
+			if inlined.Kind() != reflect.Struct {
+				continue
+			}
+			if f, ok := fieldByKey(inlined, key); ok {
+				return f, true
+			}
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		if name == key {
+			return v.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// isNull returns true if the given node is null.
+func isNull(node *yamlv3.Node) bool {
+	return node.ShortTag() == "!!null"
+}
@@ -0,0 +1,244 @@
+package yaml
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/oleg-nykolyn/goptional"
+	"github.com/stretchr/testify/require"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+type sampleServer struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+}
+
+type sampleConfig struct {
+	Name    Optional[string]        `yaml:"name"`
+	Port    Optional[int]           `yaml:"port"`
+	Tags    Optional[[]string]      `yaml:"tags"`
+	Server  Optional[*sampleServer] `yaml:"server"`
+	Timeout Optional[int]           `yaml:"timeout,omitempty"`
+}
+
+func TestConstructors(t *testing.T) {
+	empty := Empty[int]()
+	require.True(t, empty.IsEmpty())
+
+	nilSlice := Of[[]int](nil)
+	require.True(t, nilSlice.IsEmpty())
+
+	present := Of(123)
+	require.EqualValues(t, present.Unwrap(), 123)
+
+	fromEmpty := From(goptional.Empty[int]())
+	require.True(t, fromEmpty.IsEmpty())
+
+	fromNil := From[int](nil)
+	require.True(t, fromNil.IsEmpty())
+
+	fromPresent := From(goptional.Of("gm"))
+	require.EqualValues(t, fromPresent.Unwrap(), "gm")
+}
+
+func TestMarshalYAML_Empty(t *testing.T) {
+	yamlBytes, err := yamlv3.Marshal(sampleConfig{})
+	require.NoError(t, err)
+	require.EqualValues(t, string(yamlBytes), "name: null\nport: null\ntags: null\nserver: null\n")
+}
+
+func TestMarshalYAML_NotEmpty(t *testing.T) {
+	cfg := sampleConfig{
+		Name:    Of("gm"),
+		Port:    Of(0),
+		Tags:    Of([]string{"a", "b"}),
+		Server:  Of(&sampleServer{Host: "localhost", Port: 80}),
+		Timeout: Of(30),
+	}
+
+	yamlBytes, err := yamlv3.Marshal(&cfg)
+	require.NoError(t, err)
+	require.EqualValues(t, string(yamlBytes),
+		"name: gm\nport: 0\ntags:\n    - a\n    - b\nserver:\n    host: localhost\n    port: 80\ntimeout: 30\n")
+}
+
+func TestUnmarshalYAML_NullAndMissing(t *testing.T) {
+	var cfg sampleConfig
+	err := yamlv3.Unmarshal([]byte("name: ~\nport: null\ntags:\n"), &cfg)
+	require.NoError(t, err)
+
+	require.True(t, cfg.Name.IsEmpty())
+	require.True(t, cfg.Port.IsEmpty())
+	require.True(t, cfg.Tags.IsEmpty())
+	require.True(t, cfg.Server.IsEmpty())
+	require.True(t, cfg.Timeout.IsEmpty())
+}
+
+func TestUnmarshalYAML_NotEmpty(t *testing.T) {
+	var cfg sampleConfig
+	data := "name: gm\nport: 8080\ntags: [a, b]\nserver:\n  host: localhost\n  port: 80\n"
+	require.NoError(t, yamlv3.Unmarshal([]byte(data), &cfg))
+
+	require.EqualValues(t, cfg.Name.Unwrap(), "gm")
+	require.EqualValues(t, cfg.Port.Unwrap(), 8080)
+	require.EqualValues(t, cfg.Tags.Unwrap(), []string{"a", "b"})
+	require.EqualValues(t, cfg.Server.Unwrap(), &sampleServer{Host: "localhost", Port: 80})
+	require.True(t, cfg.Timeout.IsEmpty())
+}
+
+func TestUnmarshalYAML_InvalidData(t *testing.T) {
+	var cfg sampleConfig
+	err := yamlv3.Unmarshal([]byte("name: gm\nserver:\n  host: localhost\n  port: [1]\n"), &cfg)
+
+	var yErr *Error
+	require.True(t, errors.As(err, &yErr))
+	require.EqualValues(t, yErr.Line, 3)
+	require.EqualValues(t, yErr.Column, 3)
+
+	var typeErr *yamlv3.TypeError
+	require.True(t, errors.As(err, &typeErr))
+	require.Contains(t, err.Error(), "line 3, column 3")
+	require.True(t, cfg.Server.IsEmpty())
+}
+
+func TestUnmarshalYAML_Direct(t *testing.T) {
+	var opt *Optional[int]
+	require.ErrorIs(t, opt.UnmarshalYAML(&yamlv3.Node{}), goptional.ErrMutationOnNil)
+
+	opt2 := Of(123)
+	require.NoError(t, opt2.UnmarshalYAML(&yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!null", Value: "~"}))
+	require.True(t, opt2.IsEmpty())
+}
+
+func TestYAML_RoundTrip(t *testing.T) {
+	in := sampleConfig{Name: Of("gm"), Tags: Of([]string{"a"})}
+	yamlBytes, err := yamlv3.Marshal(&in)
+	require.NoError(t, err)
+
+	var out sampleConfig
+	require.NoError(t, yamlv3.Unmarshal(yamlBytes, &out))
+	require.True(t, out.Name.Equals(&in.Name.Optional))
+	require.True(t, out.Port.Equals(&in.Port.Optional))
+	require.True(t, out.Tags.Equals(&in.Tags.Optional))
+	require.True(t, out.Server.IsEmpty())
+}
+
+type sampleDefaults struct {
+	Port     Optional[int]            `yaml:"port"`
+	Name     Optional[string]         `yaml:"name"`
+	Server   *sampleNested            `yaml:"server"`
+	Embedded sampleEmbedded           `yaml:",inline"`
+	Limits   map[string]Optional[int] `yaml:"limits"`
+	Untagged Optional[int]
+	Skipped  Optional[int] `yaml:"-"`
+}
+
+type sampleNested struct {
+	Host Optional[string] `yaml:"host"`
+}
+
+type sampleEmbedded struct {
+	Debug Optional[bool] `yaml:"debug"`
+}
+
+func newSampleDefaults() sampleDefaults {
+	return sampleDefaults{
+		Port:     Of(80),
+		Name:     Of("gm"),
+		Server:   &sampleNested{Host: Of("localhost")},
+		Embedded: sampleEmbedded{Debug: Of(true)},
+		Limits:   map[string]Optional[int]{"cpu": Of(1), "mem": Of(2)},
+		Untagged: Of(1),
+		Skipped:  Of(1),
+	}
+}
+
+func TestUnmarshalYAML_NullKeepsDefault(t *testing.T) {
+	// yaml.v3 does not call unmarshalers on null nodes.
+	cfg := newSampleDefaults()
+	require.NoError(t, yamlv3.Unmarshal([]byte("port: null"), &cfg))
+	require.EqualValues(t, cfg.Port.Unwrap(), 80)
+}
+
+func TestUnmarshal_NullClearsDefault(t *testing.T) {
+	cfg := newSampleDefaults()
+	data := "port: null\nserver:\n  host: ~\ndebug: null\nlimits:\n  cpu: null\nuntagged: null\nskipped: null\n"
+	require.NoError(t, Unmarshal([]byte(data), &cfg))
+
+	require.True(t, cfg.Port.IsEmpty())
+	require.EqualValues(t, cfg.Name.Unwrap(), "gm")
+	require.True(t, cfg.Server.Host.IsEmpty())
+	require.True(t, cfg.Embedded.Debug.IsEmpty())
+	cpu, mem := cfg.Limits["cpu"], cfg.Limits["mem"]
+	require.True(t, cpu.IsEmpty())
+	require.EqualValues(t, mem.Unwrap(), 2)
+	require.True(t, cfg.Untagged.IsEmpty())
+	require.EqualValues(t, cfg.Skipped.Unwrap(), 1)
+}
+
+func TestUnmarshal_Alias(t *testing.T) {
+	cfg := newSampleDefaults()
+	require.NoError(t, Unmarshal([]byte("name: &none null\nport: *none\n"), &cfg))
+	require.True(t, cfg.Name.IsEmpty())
+	require.True(t, cfg.Port.IsEmpty())
+}
+
+func TestUnmarshal_NotNull(t *testing.T) {
+	cfg := newSampleDefaults()
+	require.NoError(t, Unmarshal([]byte("port: 8080\nserver: {host: example.com}\n"), &cfg))
+	require.EqualValues(t, cfg.Port.Unwrap(), 8080)
+	require.EqualValues(t, cfg.Server.Host.Unwrap(), "example.com")
+	require.EqualValues(t, cfg.Name.Unwrap(), "gm")
+}
+
+func TestUnmarshal_NoDocument(t *testing.T) {
+	cfg := newSampleDefaults()
+	require.NoError(t, Unmarshal(nil, &cfg))
+	require.EqualValues(t, cfg, newSampleDefaults())
+}
+
+func TestUnmarshal_InvalidData(t *testing.T) {
+	cfg := newSampleDefaults()
+	err := Unmarshal([]byte("port: [1]\nname: null\n"), &cfg)
+
+	var yErr *Error
+	require.True(t, errors.As(err, &yErr))
+	require.True(t, cfg.Name.IsEmpty())
+
+	require.Error(t, Unmarshal([]byte("port: [1"), &cfg))
+}
+
+type sampleWithUnmarshaler struct {
+	Port Optional[int] `yaml:"port"`
+}
+
+func (s *sampleWithUnmarshaler) UnmarshalYAML(node *yamlv3.Node) error {
+	type plain sampleWithUnmarshaler
+	return Decode(node, (*plain)(s))
+}
+
+func TestDecode_FromUnmarshaler(t *testing.T) {
+	var cfg struct {
+		Inner sampleWithUnmarshaler `yaml:"inner"`
+	}
+	cfg.Inner.Port = Of(80)
+
+	require.NoError(t, yamlv3.Unmarshal([]byte("inner:\n  port: null\n"), &cfg))
+	require.True(t, cfg.Inner.Port.IsEmpty())
+}
+
+func TestDecode_FromDecoder(t *testing.T) {
+	dec := yamlv3.NewDecoder(strings.NewReader("port: 1\n---\nport: null\n"))
+
+	cfg := newSampleDefaults()
+	var node yamlv3.Node
+	require.NoError(t, dec.Decode(&node))
+	require.NoError(t, Decode(&node, &cfg))
+	require.EqualValues(t, cfg.Port.Unwrap(), 1)
+
+	require.NoError(t, dec.Decode(&node))
+	require.NoError(t, Decode(&node, &cfg))
+	require.True(t, cfg.Port.IsEmpty())
+}